@@ -0,0 +1,181 @@
+package assets
+
+import (
+	"image/color"
+	"sort"
+
+	ui "github.com/itohio/tinygui"
+	"github.com/itohio/tinygui/codec"
+)
+
+// ID identifies an asset by its position in Pack.Entries. Generated packs
+// sort entries by name, so IDs stay the same as long as the set of names does.
+type ID uint16
+
+// Kind selects how an entry stores its pixels.
+type Kind uint8
+
+const (
+	// RGB565 entries hold pixels in Pack.Pixels, or as big endian symbols in
+	// Pack.Data when compressed.
+	RGB565 Kind = iota
+	// Indexed entries hold palette indices in Pack.Data, packed at Bpp bits
+	// per pixel, or one byte per pixel when compressed.
+	Indexed
+)
+
+// Entry describes one packed image.
+type Entry struct {
+	Name  string
+	W, H  uint16
+	Kind  Kind
+	Bpp   uint8
+	Codec codec.Format
+	// Palette indexes Pack.Palettes for Indexed entries.
+	Palette uint16
+	// Offset and Length locate the data in Pack.Pixels for raw RGB565
+	// entries and in Pack.Data otherwise.
+	Offset, Length uint32
+	// Key is the colour of transparent pixels, or zero for opaque images.
+	Key color.RGBA
+}
+
+// Pack is a set of images sharing their pixel storage and palettes.
+type Pack struct {
+	// Entries is the index of the pack, sorted by name.
+	Entries  []Entry
+	Pixels   []uint16
+	Data     []byte
+	Palettes []*ui.Palette
+
+	images []ui.ImageSource
+}
+
+var packs []*Pack
+
+// Register makes the names of packs visible to Lookup. Packs registered later
+// take precedence when names collide; registering a pack again does nothing.
+func Register(ps ...*Pack) {
+	for _, p := range ps {
+		if p == nil {
+			continue
+		}
+		registered := false
+		for _, old := range packs {
+			registered = registered || old == p
+		}
+		if !registered {
+			packs = append(packs, p)
+		}
+	}
+}
+
+// Packs returns the registered packs in registration order.
+func Packs() []*Pack {
+	return packs
+}
+
+// Lookup returns the image registered under name, or nil when no pack has it.
+func Lookup(name string) ui.ImageSource {
+	for i := len(packs) - 1; i >= 0; i-- {
+		if id, ok := packs[i].Find(name); ok {
+			return packs[i].Image(id)
+		}
+	}
+	return nil
+}
+
+// Source returns a provider looking name up on every call, for widgets that
+// take a func() ui.ImageSource such as widget.NewImageIcon.
+func Source(name string) func() ui.ImageSource {
+	return func() ui.ImageSource { return Lookup(name) }
+}
+
+// Len returns the number of assets in the pack.
+func (p *Pack) Len() int {
+	return len(p.Entries)
+}
+
+// Find returns the ID of the asset called name.
+func (p *Pack) Find(name string) (ID, bool) {
+	i := sort.Search(len(p.Entries), func(i int) bool { return p.Entries[i].Name >= name })
+	if i < len(p.Entries) && p.Entries[i].Name == name {
+		return ID(i), true
+	}
+	return 0, false
+}
+
+// Image returns the source drawing asset id, or nil when id is out of range
+// or its entry does not fit the pack data. The same source is returned on
+// every call.
+func (p *Pack) Image(id ID) ui.ImageSource {
+	if int(id) >= len(p.Entries) {
+		return nil
+	}
+	if p.images == nil {
+		p.images = make([]ui.ImageSource, len(p.Entries))
+	}
+	if p.images[id] == nil {
+		p.images[id] = p.source(id)
+		if key := p.Entries[id].Key; key != (color.RGBA{}) && p.images[id] != nil {
+			p.images[id] = ui.NewKeyedImage(p.images[id], key)
+		}
+	}
+	return p.images[id]
+}
+
+// Images returns the sources of ids, e.g. the choices of
+// widget.NewInteractiveImageChoice.
+func (p *Pack) Images(ids ...ID) []ui.ImageSource {
+	images := make([]ui.ImageSource, len(ids))
+	for i, id := range ids {
+		images[i] = p.Image(id)
+	}
+	return images
+}
+
+// RGB565 returns the pixels of a raw RGB565 asset without copying, e.g. for
+// widget.NewBitmap16, or nil for other assets.
+func (p *Pack) RGB565(id ID) []uint16 {
+	if int(id) >= len(p.Entries) {
+		return nil
+	}
+	e := &p.Entries[id]
+	if e.Kind != RGB565 || e.Codec != codec.Raw || !fits(e, len(p.Pixels)) {
+		return nil
+	}
+	return p.Pixels[e.Offset : e.Offset+e.Length]
+}
+
+func (p *Pack) source(id ID) ui.ImageSource {
+	e := &p.Entries[id]
+	if e.Kind == RGB565 && e.Codec == codec.Raw {
+		if pixels := p.RGB565(id); pixels != nil {
+			return ui.NewRGB565Image(e.W, e.H, pixels)
+		}
+		return nil
+	}
+	if !fits(e, len(p.Data)) {
+		return nil
+	}
+	data := p.Data[e.Offset : e.Offset+e.Length]
+	switch e.Kind {
+	case RGB565:
+		return codec.NewImage(e.W, e.H, e.Codec, data, nil)
+	case Indexed:
+		if int(e.Palette) >= len(p.Palettes) {
+			return nil
+		}
+		palette := p.Palettes[e.Palette]
+		if e.Codec != codec.Raw {
+			return codec.NewImage(e.W, e.H, e.Codec, data, palette)
+		}
+		return ui.NewIndexedImage(e.W, e.H, e.Bpp, data, palette)
+	}
+	return nil
+}
+
+// fits reports whether the data of e lies within a blob of size n.
+func fits(e *Entry, n int) bool {
+	return uint64(e.Offset)+uint64(e.Length) <= uint64(n)
+}
@@ -0,0 +1,78 @@
+package assets
+
+import (
+	"image/color"
+	"testing"
+
+	ui "github.com/itohio/tinygui"
+	"github.com/itohio/tinygui/codec"
+	"github.com/stretchr/testify/require"
+)
+
+const blue = 0x001F
+
+func draw(t *testing.T, src ui.ImageSource) []uint16 {
+	t.Helper()
+	require.NotNil(t, src)
+	w, h := src.Size()
+	fb := ui.NewFramebuffer(int16(w), int16(h), ui.PixelFormatRGB565)
+	fb.FillScreen(color.RGBA{0, 0, 0xFF, 0xFF})
+	require.NoError(t, ui.DrawImage(fb, 0, 0, src))
+	out := make([]uint16, 0, int(w)*int(h))
+	for y := int16(0); y < int16(h); y++ {
+		for x := int16(0); x < int16(w); x++ {
+			out = append(out, ui.RGBATo565(fb.GetPixel(x, y)))
+		}
+	}
+	return out
+}
+
+func testPack(t *testing.T) *Pack {
+	t.Helper()
+	rle, err := codec.Encode(codec.RLE, 1, []uint16{1, 1, 1, 0})
+	require.NoError(t, err)
+	grey := ui.NewPalette(color.RGBA{A: 0xFF}, color.RGBA{0xFF, 0xFF, 0xFF, 0xFF})
+	return &Pack{
+		Entries: []Entry{
+			{Name: "dot", W: 2, H: 2, Kind: Indexed, Bpp: 1, Offset: 0, Length: 2},
+			{Name: "raw", W: 2, H: 1, Offset: 2, Length: 2},
+			{Name: "runs", W: 2, H: 2, Kind: Indexed, Bpp: 8, Codec: codec.RLE, Offset: 2, Length: uint32(len(rle)), Key: color.RGBA{A: 0xFF}},
+			{Name: "torn", W: 4, H: 4, Offset: 3, Length: 16},
+		},
+		Pixels:   []uint16{0, 0, 0xF800, 0x07E0},
+		Data:     append([]byte{0x80, 0x40}, rle...),
+		Palettes: []*ui.Palette{grey},
+	}
+}
+
+func TestPackImages(t *testing.T) {
+	p := testPack(t)
+	id, ok := p.Find("raw")
+	require.True(t, ok)
+	require.Equal(t, ID(1), id)
+	_, ok = p.Find("missing")
+	require.False(t, ok)
+
+	require.Equal(t, []uint16{0xFFFF, 0, 0, 0xFFFF}, draw(t, p.Image(0)))
+	require.Equal(t, []uint16{0xF800, 0x07E0}, draw(t, p.Image(1)))
+	require.Equal(t, []uint16{0xFFFF, 0xFFFF, 0xFFFF, blue}, draw(t, p.Image(2)), "the key leaves black pixels out")
+	require.Same(t, p.Image(1), p.Image(1))
+
+	require.Equal(t, []uint16{0xF800, 0x07E0}, p.RGB565(1))
+	require.Nil(t, p.RGB565(0))
+	require.Nil(t, p.Image(3), "entries beyond the data have no image")
+	require.Nil(t, p.Image(4))
+	require.Len(t, p.Images(2, 0), 2)
+}
+
+func TestRegisterAndLookup(t *testing.T) {
+	t.Cleanup(func() { packs = nil })
+	base := testPack(t)
+	override := &Pack{Entries: []Entry{{Name: "raw", W: 1, H: 1, Length: 1}}, Pixels: []uint16{blue}}
+	Register(base, override, base)
+	require.Len(t, Packs(), 2)
+
+	require.Equal(t, []uint16{blue}, draw(t, Lookup("raw")), "later packs take precedence")
+	require.Equal(t, base.Image(0), Source("dot")())
+	require.Nil(t, Lookup("missing"))
+}
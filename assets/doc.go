@@ -0,0 +1,8 @@
+// Package assets looks up images packed into one generated table by
+// cmd/assetpack. A Pack keeps every image of a directory in two shared blobs,
+// RGB565 words and bytes, with an index of names, dimensions and formats and
+// a deduplicated list of palettes. Generated packages declare an ID constant
+// per asset and register their Pack in init, so images can be found by ID on
+// the pack or by name through Lookup. Sources are built once per asset and
+// reused, so repeated lookups do not allocate.
+package assets
@@ -0,0 +1,205 @@
+package ui
+
+import (
+	"image/color"
+
+	"tinygo.org/x/drivers"
+)
+
+var _ Displayer = (*BlendDisplayer)(nil)
+
+// PixelReader is implemented by displayers that can read back what is on
+// screen, such as Framebuffer.
+type PixelReader interface {
+	GetPixel(x, y int16) color.RGBA
+}
+
+// ReadPixel returns the colour at (x,y) when d, or a clipping or transforming
+// wrapper around it, can read pixels back.
+func ReadPixel(d drivers.Displayer, x, y int16) (color.RGBA, bool) {
+	switch t := d.(type) {
+	case PixelReader:
+		return t.GetPixel(x, y), true
+	case *ClipDisplayer:
+		return ReadPixel(t.d, x, y)
+	case *BlendDisplayer:
+		return ReadPixel(t.d, x, y)
+	case *TransformDisplayer:
+		w, h := t.Size()
+		if x < 0 || y < 0 || x >= w || y >= h {
+			return color.RGBA{}, false
+		}
+		rx, ry := t.forward(x, y)
+		return ReadPixel(t.d, rx*t.scale, ry*t.scale)
+	}
+	return color.RGBA{}, false
+}
+
+// Blend composites src over dst using src.A as straight (non-premultiplied)
+// opacity. The result keeps the opacity of dst.
+func Blend(dst, src color.RGBA) color.RGBA {
+	switch src.A {
+	case 0xFF:
+		return src
+	case 0:
+		return dst
+	}
+	a := uint16(src.A)
+	return color.RGBA{
+		R: mix8(dst.R, src.R, a),
+		G: mix8(dst.G, src.G, a),
+		B: mix8(dst.B, src.B, a),
+		A: dst.A,
+	}
+}
+
+// WithCoverage scales the opacity of c by coverage (0 transparent, 255 fully
+// covered), as used by anti-aliased primitives for edge pixels.
+func WithCoverage(c color.RGBA, coverage uint8) color.RGBA {
+	c.A = uint8((uint16(c.A)*uint16(coverage) + 127) / 255)
+	return c
+}
+
+// BlendPixel draws c at (x,y) honouring its alpha. Translucent colours are
+// blended with the pixel read back from d when possible and with bg
+// otherwise. Without either, pixels at least half opaque are drawn solid and
+// the rest are skipped.
+func BlendPixel(d drivers.Displayer, x, y int16, c, bg color.RGBA) {
+	switch c.A {
+	case 0xFF:
+		d.SetPixel(x, y, c)
+		return
+	case 0:
+		return
+	}
+	if under, ok := ReadPixel(d, x, y); ok {
+		d.SetPixel(x, y, Blend(under, c))
+		return
+	}
+	if bg != (color.RGBA{}) {
+		d.SetPixel(x, y, Blend(bg, c))
+		return
+	}
+	if c.A >= 0x80 {
+		c.A = 0xFF
+		d.SetPixel(x, y, c)
+	}
+}
+
+// BlendDisplayer gives the alpha channel meaning for everything drawn through
+// it, including text from tinyfont. Opaque drawing passes straight through to
+// the wrapped displayer's fast paths. Translucent drawing is blended against
+// the screen when it can be read back, otherwise against the declared
+// background, in which case rectangles still fill in a single call.
+type BlendDisplayer struct {
+	d  drivers.Displayer
+	bg color.RGBA
+}
+
+// NewBlendDisplayer blends drawing on d against bg where pixels cannot be read
+// back.
+func NewBlendDisplayer(d drivers.Displayer, bg color.RGBA) *BlendDisplayer {
+	return &BlendDisplayer{d: d, bg: bg}
+}
+
+// Background returns the declared background colour.
+func (b *BlendDisplayer) Background() color.RGBA { return b.bg }
+
+// SetBackground changes the declared background colour.
+func (b *BlendDisplayer) SetBackground(bg color.RGBA) { b.bg = bg }
+
+// Unwrap returns the displayer being blended onto.
+func (b *BlendDisplayer) Unwrap() drivers.Displayer { return b.d }
+
+func (b *BlendDisplayer) Size() (int16, int16) { return b.d.Size() }
+func (b *BlendDisplayer) Display() error       { return b.d.Display() }
+
+func (b *BlendDisplayer) SetPixel(x, y int16, c color.RGBA) {
+	BlendPixel(b.d, x, y, c, b.bg)
+}
+
+// FillRectangle fills opaque colours through the fast path and blends
+// translucent ones.
+func (b *BlendDisplayer) FillRectangle(x, y, width, height int16, c color.RGBA) error {
+	if width <= 0 || height <= 0 || c.A == 0 {
+		return nil
+	}
+	if c.A != 0xFF {
+		if _, ok := ReadPixel(b.d, x, y); ok || b.bg == (color.RGBA{}) {
+			for dy := int16(0); dy < height; dy++ {
+				for dx := int16(0); dx < width; dx++ {
+					BlendPixel(b.d, x+dx, y+dy, c, b.bg)
+				}
+			}
+			return nil
+		}
+		c = Blend(b.bg, c)
+	}
+	FillRect(b.d, x, y, width, height, c)
+	return nil
+}
+
+func (b *BlendDisplayer) FillRectangleWithBuffer(x, y, width, height int16, buffer []color.RGBA) error {
+	if width <= 0 || height <= 0 {
+		return nil
+	}
+	if int(width)*int(height) != len(buffer) {
+		return errBufferSize
+	}
+	for i, c := range buffer {
+		BlendPixel(b.d, x+int16(i%int(width)), y+int16(i/int(width)), c, b.bg)
+	}
+	return nil
+}
+
+func (b *BlendDisplayer) FillScreen(c color.RGBA) {
+	w, h := b.d.Size()
+	_ = b.FillRectangle(0, 0, w, h, c)
+}
+
+func (b *BlendDisplayer) DrawFastHLine(x0, x1, y int16, c color.RGBA) {
+	if x0 > x1 {
+		x0, x1 = x1, x0
+	}
+	_ = b.FillRectangle(x0, y, x1-x0+1, 1, c)
+}
+
+func (b *BlendDisplayer) DrawFastVLine(x, y0, y1 int16, c color.RGBA) {
+	if y0 > y1 {
+		y0, y1 = y1, y0
+	}
+	_ = b.FillRectangle(x, y0, 1, y1-y0+1, c)
+}
+
+// DrawRGBBitmap passes opaque RGB565 bitmaps through.
+func (b *BlendDisplayer) DrawRGBBitmap(x, y int16, data []uint16, w, h int16) error {
+	if bmp, ok := b.d.(BitmapDisplayer); ok {
+		return bmp.DrawRGBBitmap(x, y, data, w, h)
+	}
+	if int(w)*int(h) != len(data) {
+		return errBufferSize
+	}
+	for i, v := range data {
+		b.d.SetPixel(x+int16(i%int(w)), y+int16(i/int(w)), RGB565ToRGBA(v))
+	}
+	return nil
+}
+
+// DrawRGBBitmap8 passes opaque big-endian RGB565 byte bitmaps through.
+func (b *BlendDisplayer) DrawRGBBitmap8(x, y int16, data []uint8, w, h int16) error {
+	if bmp, ok := b.d.(BitmapDisplayer); ok {
+		return bmp.DrawRGBBitmap8(x, y, data, w, h)
+	}
+	if int(w)*int(h)*2 != len(data) {
+		return errBufferSize
+	}
+	for i := 0; i < len(data); i += 2 {
+		p := i / 2
+		b.d.SetPixel(x+int16(p%int(w)), y+int16(p/int(w)), RGB565ToRGBA(uint16(data[i])<<8|uint16(data[i+1])))
+	}
+	return nil
+}
+
+func mix8(dst, src uint8, a uint16) uint8 {
+	return uint8((uint16(src)*a + uint16(dst)*(0xFF-a) + 127) / 0xFF)
+}
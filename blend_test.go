@@ -0,0 +1,75 @@
+package ui_test
+
+import (
+	"image/color"
+	"testing"
+
+	ui "github.com/itohio/tinygui"
+	"github.com/stretchr/testify/require"
+)
+
+// writeOnly exposes only SetPixel, so nothing can be read back.
+type writeOnly struct {
+	fb    *ui.Framebuffer
+	fills int
+}
+
+func (w *writeOnly) Size() (int16, int16)              { return w.fb.Size() }
+func (w *writeOnly) SetPixel(x, y int16, c color.RGBA) { w.fb.SetPixel(x, y, c) }
+func (w *writeOnly) Display() error                    { return nil }
+
+func (w *writeOnly) FillRectangle(x, y, width, height int16, c color.RGBA) error {
+	w.fills++
+	return w.fb.FillRectangle(x, y, width, height, c)
+}
+
+func (w *writeOnly) FillRectangleWithBuffer(x, y, width, height int16, buffer []color.RGBA) error {
+	return w.fb.FillRectangleWithBuffer(x, y, width, height, buffer)
+}
+
+func (w *writeOnly) FillScreen(c color.RGBA) { w.fb.FillScreen(c) }
+
+func TestBlend(t *testing.T) {
+	red := color.RGBA{0xFF, 0, 0, 0xFF}
+	blue := color.RGBA{0, 0, 0xFF, 0xFF}
+
+	require.Equal(t, red, ui.Blend(blue, red))
+	require.Equal(t, blue, ui.Blend(blue, color.RGBA{0xFF, 0, 0, 0}))
+	require.Equal(t, color.RGBA{0x80, 0, 0x7F, 0xFF}, ui.Blend(blue, color.RGBA{0xFF, 0, 0, 0x80}))
+	require.Equal(t, uint8(0x40), ui.WithCoverage(color.RGBA{0, 0, 0, 0x80}, 0x80).A)
+}
+
+func TestBlendPixelReadsBack(t *testing.T) {
+	fb := ui.NewFramebuffer(4, 4, ui.PixelFormatRGB888)
+	fb.FillScreen(color.RGBA{0, 0, 0xFF, 0xFF})
+	half := color.RGBA{0xFF, 0, 0, 0x80}
+
+	// The framebuffer contents win over the declared background.
+	ui.BlendPixel(fb, 1, 1, half, color.RGBA{0, 0xFF, 0, 0xFF})
+	require.Equal(t, color.RGBA{0x80, 0, 0x7F, 0xFF}, fb.GetPixel(1, 1))
+
+	// Reading back works through clipping wrappers too.
+	clip := ui.NewClipDisplayer(fb, ui.Rect{X: 0, Y: 0, W: 2, H: 2})
+	ui.BlendPixel(clip, 0, 0, half, color.RGBA{})
+	require.Equal(t, color.RGBA{0x80, 0, 0x7F, 0xFF}, fb.GetPixel(0, 0))
+}
+
+func TestBlendDisplayerDeclaredBackground(t *testing.T) {
+	wo := &writeOnly{fb: ui.NewFramebuffer(4, 4, ui.PixelFormatRGB888)}
+	b := ui.NewBlendDisplayer(wo, color.RGBA{0, 0, 0xFF, 0xFF})
+
+	// Without read-back a translucent fill is one blended fast-path fill.
+	require.NoError(t, b.FillRectangle(0, 0, 4, 4, color.RGBA{0xFF, 0, 0, 0x80}))
+	require.Equal(t, 1, wo.fills)
+	require.Equal(t, color.RGBA{0x80, 0, 0x7F, 0xFF}, wo.fb.GetPixel(3, 3))
+
+	b.SetPixel(0, 0, color.RGBA{0, 0xFF, 0, 0})
+	require.Equal(t, color.RGBA{0x80, 0, 0x7F, 0xFF}, wo.fb.GetPixel(0, 0))
+
+	// Without read-back or background, alpha degrades to a threshold.
+	none := ui.NewBlendDisplayer(wo, color.RGBA{})
+	none.SetPixel(1, 0, color.RGBA{0xFF, 0xFF, 0xFF, 0x40})
+	none.SetPixel(2, 0, color.RGBA{0xFF, 0xFF, 0xFF, 0xC0})
+	require.Equal(t, color.RGBA{0x80, 0, 0x7F, 0xFF}, wo.fb.GetPixel(1, 0))
+	require.Equal(t, color.RGBA{0xFF, 0xFF, 0xFF, 0xFF}, wo.fb.GetPixel(2, 0))
+}
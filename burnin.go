@@ -0,0 +1,231 @@
+package ui
+
+import (
+	"image/color"
+	"math"
+	"math/rand"
+
+	"tinygo.org/x/drivers"
+)
+
+// BurnInStrategy moves RandomContext content to protect OLED panels. Next is
+// called once per interval with the current origin and the largest origin
+// that keeps the context on the panel, and returns the new origin.
+type BurnInStrategy interface {
+	Next(x, y, maxX, maxY int16) (int16, int16)
+}
+
+// BurnInFilter is implemented by strategies that alter colours rather than, or
+// in addition to, moving content. RandomContext draws through Filter(d).
+type BurnInFilter interface {
+	Filter(d drivers.Displayer) drivers.Displayer
+}
+
+// BurnInPainter is implemented by strategies that draw on the panel
+// themselves. RandomContext calls Paint with its displayer after every step.
+type BurnInPainter interface {
+	Paint(d drivers.Displayer)
+}
+
+// RandomJump jumps to a random origin on every step. It is the default
+// strategy of RandomContext.
+type RandomJump struct {
+	rng *rand.Rand
+}
+
+// NewRandomJump returns a jump strategy. A nil rng uses the global source.
+func NewRandomJump(rng *rand.Rand) *RandomJump {
+	return &RandomJump{rng: rng}
+}
+
+func (j *RandomJump) Next(x, y, maxX, maxY int16) (int16, int16) {
+	return int16(j.intn(int32(maxX) + 1)), int16(j.intn(int32(maxY) + 1))
+}
+
+func (j *RandomJump) intn(n int32) int32 {
+	if j.rng == nil {
+		return rand.Int31n(n)
+	}
+	return j.rng.Int31n(n)
+}
+
+// SquareOrbit walks the perimeter of a square one pixel per step.
+type SquareOrbit struct {
+	side int16
+	pos  int16
+}
+
+// NewSquareOrbit returns an orbit along a square with the given side length.
+func NewSquareOrbit(side int16) *SquareOrbit {
+	return &SquareOrbit{side: side}
+}
+
+func (o *SquareOrbit) Next(x, y, maxX, maxY int16) (int16, int16) {
+	side := o.side
+	if side <= 0 {
+		return 0, 0
+	}
+	o.pos = (o.pos + 1) % (4 * side)
+	var px, py int16
+	switch edge, off := o.pos/side, o.pos%side; edge {
+	case 0:
+		px, py = off, 0
+	case 1:
+		px, py = side, off
+	case 2:
+		px, py = side-off, side
+	default:
+		px, py = 0, side-off
+	}
+	return clamp16(px, maxX), clamp16(py, maxY)
+}
+
+// CircleOrbit moves around a circle in a fixed number of steps.
+type CircleOrbit struct {
+	radius int16
+	steps  int
+	step   int
+}
+
+// NewCircleOrbit returns an orbit of the given radius completed in steps moves.
+func NewCircleOrbit(radius int16, steps int) *CircleOrbit {
+	if steps <= 0 {
+		steps = 1
+	}
+	return &CircleOrbit{radius: radius, steps: steps}
+}
+
+func (o *CircleOrbit) Next(x, y, maxX, maxY int16) (int16, int16) {
+	o.step = (o.step + 1) % o.steps
+	angle := 2 * math.Pi * float64(o.step) / float64(o.steps)
+	r := float64(o.radius)
+	px := int16(math.Round(r + r*math.Cos(angle)))
+	py := int16(math.Round(r + r*math.Sin(angle)))
+	return clamp16(px, maxX), clamp16(py, maxY)
+}
+
+// RandomWalk moves at most one pixel per axis and step, staying within bound
+// pixels of the top-left origin. A fixed seed makes the walk reproducible.
+type RandomWalk struct {
+	rng   *rand.Rand
+	bound int16
+}
+
+// NewRandomWalk returns a seeded walk bounded to bound pixels.
+func NewRandomWalk(seed int64, bound int16) *RandomWalk {
+	return &RandomWalk{rng: rand.New(rand.NewSource(seed)), bound: bound}
+}
+
+func (w *RandomWalk) Next(x, y, maxX, maxY int16) (int16, int16) {
+	limitX, limitY := min16(w.bound, maxX), min16(w.bound, maxY)
+	x += int16(w.rng.Intn(3)) - 1
+	y += int16(w.rng.Intn(3)) - 1
+	return clamp16(x, limitX), clamp16(y, limitY)
+}
+
+// Inversion inverts all colours on every other step without moving content.
+type Inversion struct {
+	inverted bool
+	filter   FilterDisplayer
+}
+
+// NewInversion returns a strategy toggling colour inversion each step.
+func NewInversion() *Inversion {
+	return &Inversion{filter: FilterDisplayer{fn: invertColor}}
+}
+
+func (i *Inversion) Next(x, y, maxX, maxY int16) (int16, int16) {
+	i.inverted = !i.inverted
+	return x, y
+}
+
+func (i *Inversion) Filter(d drivers.Displayer) drivers.Displayer {
+	if !i.inverted {
+		return d
+	}
+	w, h := d.Size()
+	i.filter.Reset(d, Rect{W: w, H: h})
+	return &i.filter
+}
+
+// PixelRefresh sweeps a solid band across the full panel, one band width per
+// step, then rests for a number of steps before the next sweep. Each step
+// paints the band on the panel, driving pixels no widget draws, and Filter
+// keeps drawing inside the band in the band colour. The previous band stays
+// until the panel is repainted, which Renderer does when RandomContext changes
+// its version.
+type PixelRefresh struct {
+	band   int16
+	rest   int
+	col    color.RGBA
+	step   int
+	filter FilterDisplayer
+}
+
+// NewPixelRefresh returns a sweep painting bands of the given width in col.
+func NewPixelRefresh(band int16, rest int, col color.RGBA) *PixelRefresh {
+	if band <= 0 {
+		band = 1
+	}
+	p := &PixelRefresh{band: band, rest: rest, col: col, step: -1}
+	p.filter.fn = func(color.RGBA) color.RGBA { return p.col }
+	return p
+}
+
+func (p *PixelRefresh) Next(x, y, maxX, maxY int16) (int16, int16) {
+	p.step++
+	return x, y
+}
+
+// Active reports whether a band is currently being swept.
+func (p *PixelRefresh) Active(width int16) bool {
+	return p.step >= 0 && p.bandX(width) < width
+}
+
+// Paint fills the current band on d.
+func (p *PixelRefresh) Paint(d drivers.Displayer) {
+	w, h := d.Size()
+	if p.Active(w) {
+		x := p.bandX(w)
+		FillRect(d, x, 0, min16(p.band, w-x), h, p.col)
+	}
+}
+
+func (p *PixelRefresh) Filter(d drivers.Displayer) drivers.Displayer {
+	w, h := d.Size()
+	if !p.Active(w) {
+		return d
+	}
+	p.filter.Reset(d, Rect{X: p.bandX(w), W: p.band, H: h})
+	return &p.filter
+}
+
+func (p *PixelRefresh) bandX(width int16) int16 {
+	bands := (int(width) + int(p.band) - 1) / int(p.band)
+	cycle := p.step % (bands + p.rest)
+	if cycle >= bands {
+		return width
+	}
+	return int16(cycle) * p.band
+}
+
+func invertColor(c color.RGBA) color.RGBA {
+	return color.RGBA{R: ^c.R, G: ^c.G, B: ^c.B, A: c.A}
+}
+
+func clamp16(v, max int16) int16 {
+	if v < 0 {
+		return 0
+	}
+	if v > max {
+		return max
+	}
+	return v
+}
+
+func min16(a, b int16) int16 {
+	if a < b {
+		return a
+	}
+	return b
+}
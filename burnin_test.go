@@ -0,0 +1,146 @@
+package ui_test
+
+import (
+	"image/color"
+	"testing"
+	"time"
+
+	ui "github.com/itohio/tinygui"
+	"github.com/itohio/tinygui/container"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSquareOrbitWalksPerimeter(t *testing.T) {
+	orbit := ui.NewSquareOrbit(2)
+	var got [][2]int16
+	for i := 0; i < 8; i++ {
+		x, y := orbit.Next(0, 0, 10, 10)
+		got = append(got, [2]int16{x, y})
+	}
+	require.Equal(t, [][2]int16{{1, 0}, {2, 0}, {2, 1}, {2, 2}, {1, 2}, {0, 2}, {0, 1}, {0, 0}}, got)
+
+	x, y := orbit.Next(0, 0, 1, 0)
+	require.Equal(t, int16(1), x)
+	require.Equal(t, int16(0), y)
+}
+
+func TestRandomWalkIsSeededAndBounded(t *testing.T) {
+	a := ui.NewRandomWalk(7, 3)
+	b := ui.NewRandomWalk(7, 3)
+	var ax, ay, bx, by int16
+	for i := 0; i < 100; i++ {
+		ax, ay = a.Next(ax, ay, 20, 2)
+		bx, by = b.Next(bx, by, 20, 2)
+		require.Equal(t, ax, bx)
+		require.Equal(t, ay, by)
+		require.True(t, ax >= 0 && ax <= 3)
+		require.True(t, ay >= 0 && ay <= 2)
+	}
+}
+
+func TestInversionFiltersColours(t *testing.T) {
+	fb := ui.NewFramebuffer(4, 4, ui.PixelFormatRGB888)
+	inv := ui.NewInversion()
+	require.Equal(t, fb, inv.Filter(fb))
+
+	inv.Next(0, 0, 0, 0)
+	d := inv.Filter(fb)
+	ui.FillRect(d, 0, 0, 4, 4, color.RGBA{0xFF, 0, 0, 0xFF})
+	require.Equal(t, color.RGBA{0, 0xFF, 0xFF, 0xFF}, fb.GetPixel(3, 3))
+
+	bmp := d.(ui.BitmapDisplayer)
+	require.NoError(t, bmp.DrawRGBBitmap(0, 0, []uint16{0xFFFF}, 1, 1))
+	require.Equal(t, color.RGBA{0, 0, 0, 0xFF}, fb.GetPixel(0, 0))
+}
+
+func TestPixelRefreshSweepsBands(t *testing.T) {
+	white := color.RGBA{0xFF, 0xFF, 0xFF, 0xFF}
+	black := color.RGBA{0, 0, 0, 0xFF}
+	fb := ui.NewFramebuffer(6, 2, ui.PixelFormatRGB888)
+	sweep := ui.NewPixelRefresh(4, 1, white)
+	require.Equal(t, fb, sweep.Filter(fb))
+
+	var bands []int16
+	for i := 0; i < 4; i++ {
+		sweep.Next(0, 0, 0, 0)
+		fb.FillScreen(black)
+		sweep.Paint(fb)
+		band := int16(-1)
+		for x := int16(0); x < 6; x++ {
+			if fb.GetPixel(x, 1) == white {
+				band = x
+				break
+			}
+		}
+		bands = append(bands, band)
+	}
+	require.Equal(t, []int16{0, 4, -1, 0}, bands)
+}
+
+func TestPixelRefreshDrivesUnlitPixels(t *testing.T) {
+	white := color.RGBA{0xFF, 0xFF, 0xFF, 0xFF}
+	red := color.RGBA{0xFF, 0, 0, 0xFF}
+	black := color.RGBA{0, 0, 0, 0xFF}
+	clock := ui.NewManualClock(time.Unix(0, 0))
+	// The widgets cover the left half of the panel and, without a renderer
+	// background, nothing draws the right half.
+	fb := ui.NewFramebuffer(8, 2, ui.PixelFormatRGB888)
+	fb.FillScreen(black)
+	ctx := ui.NewRandomContext(fb, time.Second, 4, 2,
+		ui.WithRandomClock(clock),
+		ui.WithBurnInStrategy(ui.NewPixelRefresh(4, 1, white)),
+	)
+	child := newCountingWidget(4, 2, red)
+	root := container.New[ui.Widget](4, 2, container.WithChildren[ui.Widget](child))
+	r := ui.NewRenderer(root, &ctx, ui.WithRendererBackground(color.RGBA{}))
+
+	columns := func() []color.RGBA {
+		out := make([]color.RGBA, 8)
+		for x := range out {
+			out[x] = fb.GetPixel(int16(x), 1)
+			require.Equal(t, out[x], fb.GetPixel(int16(x), 0), "bands span the panel height")
+		}
+		return out
+	}
+	r.Render()
+	require.Equal(t, []color.RGBA{red, red, red, red, black, black, black, black}, columns())
+
+	clock.Advance(time.Second)
+	child.Invalidate()
+	r.Render()
+	require.Equal(t, []color.RGBA{white, white, white, white, black, black, black, black}, columns(), "widgets in the band draw in its colour")
+
+	clock.Advance(time.Second)
+	child.Invalidate()
+	r.Render()
+	require.Equal(t, []color.RGBA{red, red, red, red, white, white, white, white}, columns(), "the band drives pixels no widget draws")
+
+	clock.Advance(time.Second)
+	child.Invalidate()
+	r.Render()
+	require.Equal(t, []color.RGBA{red, red, red, red, white, white, white, white}, columns(), "the last band stays until the panel is cleared")
+}
+
+func TestRandomContextUsesStrategy(t *testing.T) {
+	clock := ui.NewManualClock(time.Unix(0, 0))
+	fb := ui.NewFramebuffer(12, 12, ui.PixelFormatMono)
+	ctx := ui.NewRandomContext(fb, time.Second, 10, 10,
+		ui.WithRandomClock(clock),
+		ui.WithBurnInStrategy(ui.NewSquareOrbit(4)),
+	)
+
+	clock.Advance(time.Second)
+	child := ctx.Clone(nil, 10, 10)
+	x, y := child.Start()
+	require.Equal(t, int16(1), x)
+	require.Equal(t, int16(0), y)
+	require.Equal(t, uint32(1), ctx.Version())
+
+	for i := 0; i < 4; i++ {
+		clock.Advance(time.Second)
+		ctx.Clone(nil, 10, 10)
+	}
+	x, y = ctx.Start()
+	require.Equal(t, int16(2), x)
+	require.Equal(t, int16(1), y)
+}
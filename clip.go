@@ -0,0 +1,181 @@
+package ui
+
+import (
+	"image/color"
+
+	"tinygo.org/x/drivers"
+)
+
+var _ Displayer = (*ClipDisplayer)(nil)
+
+// ClipDisplayer wraps a displayer and discards everything drawn outside its
+// clip rectangle. Accelerated calls are trimmed to the visible area before they
+// reach the wrapped displayer, falling back to SetPixel when it lacks them.
+type ClipDisplayer struct {
+	d    drivers.Displayer
+	clip Rect
+}
+
+// NewClipDisplayer restricts drawing on d to clip. Wrapping another
+// ClipDisplayer intersects both rectangles instead of stacking wrappers.
+func NewClipDisplayer(d drivers.Displayer, clip Rect) *ClipDisplayer {
+	c := newClipDisplayer(d, clip)
+	return &c
+}
+
+func newClipDisplayer(d drivers.Displayer, clip Rect) ClipDisplayer {
+	if inner, ok := d.(*ClipDisplayer); ok {
+		return ClipDisplayer{d: inner.d, clip: clip.Intersect(inner.clip)}
+	}
+	return ClipDisplayer{d: d, clip: clip}
+}
+
+// Clip returns the active clip rectangle.
+func (c *ClipDisplayer) Clip() Rect { return c.clip }
+
+// Unwrap returns the displayer being clipped.
+func (c *ClipDisplayer) Unwrap() drivers.Displayer { return c.d }
+
+func (c *ClipDisplayer) Size() (int16, int16) { return c.d.Size() }
+func (c *ClipDisplayer) Display() error       { return c.d.Display() }
+
+func (c *ClipDisplayer) SetPixel(x, y int16, col color.RGBA) {
+	if c.clip.Contains(x, y) {
+		c.d.SetPixel(x, y, col)
+	}
+}
+
+// FillRectangle fills the visible part of the rectangle.
+func (c *ClipDisplayer) FillRectangle(x, y, width, height int16, col color.RGBA) error {
+	r := c.clip.Intersect(Rect{X: x, Y: y, W: width, H: height})
+	if r.Empty() {
+		return nil
+	}
+	if fast, ok := c.d.(RectangleDisplayer); ok {
+		return fast.FillRectangle(r.X, r.Y, r.W, r.H, col)
+	}
+	for dy := int16(0); dy < r.H; dy++ {
+		HLine(c.d, r.X, r.Y+dy, r.W, col)
+	}
+	return nil
+}
+
+// FillRectangleWithBuffer copies the visible part of the buffer.
+func (c *ClipDisplayer) FillRectangleWithBuffer(x, y, width, height int16, buffer []color.RGBA) error {
+	if width <= 0 || height <= 0 {
+		return nil
+	}
+	if int(width)*int(height) != len(buffer) {
+		return errBufferSize
+	}
+	full := Rect{X: x, Y: y, W: width, H: height}
+	r := c.clip.Intersect(full)
+	if r.Empty() {
+		return nil
+	}
+	fast, ok := c.d.(RectangleDisplayer)
+	if ok && r == full {
+		return fast.FillRectangleWithBuffer(x, y, width, height, buffer)
+	}
+	for dy := r.Y - y; dy < r.Y-y+r.H; dy++ {
+		start := int(dy)*int(width) + int(r.X-x)
+		row := buffer[start : start+int(r.W)]
+		if ok {
+			if err := fast.FillRectangleWithBuffer(r.X, y+dy, r.W, 1, row); err != nil {
+				return err
+			}
+			continue
+		}
+		for i, col := range row {
+			c.d.SetPixel(r.X+int16(i), y+dy, col)
+		}
+	}
+	return nil
+}
+
+// FillScreen only paints the clip rectangle.
+func (c *ClipDisplayer) FillScreen(col color.RGBA) {
+	_ = c.FillRectangle(c.clip.X, c.clip.Y, c.clip.W, c.clip.H, col)
+}
+
+func (c *ClipDisplayer) DrawFastHLine(x0, x1, y int16, col color.RGBA) {
+	if x0 > x1 {
+		x0, x1 = x1, x0
+	}
+	_ = c.FillRectangle(x0, y, x1-x0+1, 1, col)
+}
+
+func (c *ClipDisplayer) DrawFastVLine(x, y0, y1 int16, col color.RGBA) {
+	if y0 > y1 {
+		y0, y1 = y1, y0
+	}
+	_ = c.FillRectangle(x, y0, 1, y1-y0+1, col)
+}
+
+// DrawRGBBitmap draws the visible part of an RGB565 bitmap. Bitmaps cut only
+// at the top or bottom are sent in one call, otherwise row by row.
+func (c *ClipDisplayer) DrawRGBBitmap(x, y int16, data []uint16, w, h int16) error {
+	if w <= 0 || h <= 0 {
+		return nil
+	}
+	if int(w)*int(h) != len(data) {
+		return errBufferSize
+	}
+	r := c.clip.Intersect(Rect{X: x, Y: y, W: w, H: h})
+	if r.Empty() {
+		return nil
+	}
+	bmp, ok := c.d.(BitmapDisplayer)
+	top := int(r.Y - y)
+	if ok && r.X == x && r.W == w {
+		return bmp.DrawRGBBitmap(x, r.Y, data[top*int(w):(top+int(r.H))*int(w)], w, r.H)
+	}
+	for dy := 0; dy < int(r.H); dy++ {
+		start := (top+dy)*int(w) + int(r.X-x)
+		row := data[start : start+int(r.W)]
+		if ok {
+			if err := bmp.DrawRGBBitmap(r.X, r.Y+int16(dy), row, r.W, 1); err != nil {
+				return err
+			}
+			continue
+		}
+		for i, v := range row {
+			c.d.SetPixel(r.X+int16(i), r.Y+int16(dy), RGB565ToRGBA(v))
+		}
+	}
+	return nil
+}
+
+// DrawRGBBitmap8 draws the visible part of a big-endian RGB565 byte bitmap.
+func (c *ClipDisplayer) DrawRGBBitmap8(x, y int16, data []uint8, w, h int16) error {
+	if w <= 0 || h <= 0 {
+		return nil
+	}
+	if int(w)*int(h)*2 != len(data) {
+		return errBufferSize
+	}
+	r := c.clip.Intersect(Rect{X: x, Y: y, W: w, H: h})
+	if r.Empty() {
+		return nil
+	}
+	bmp, ok := c.d.(BitmapDisplayer)
+	stride := int(w) * 2
+	top := int(r.Y - y)
+	if ok && r.X == x && r.W == w {
+		return bmp.DrawRGBBitmap8(x, r.Y, data[top*stride:(top+int(r.H))*stride], w, r.H)
+	}
+	for dy := 0; dy < int(r.H); dy++ {
+		start := (top+dy)*stride + int(r.X-x)*2
+		row := data[start : start+int(r.W)*2]
+		if ok {
+			if err := bmp.DrawRGBBitmap8(r.X, r.Y+int16(dy), row, r.W, 1); err != nil {
+				return err
+			}
+			continue
+		}
+		for i := 0; i < len(row); i += 2 {
+			c.d.SetPixel(r.X+int16(i/2), r.Y+int16(dy), RGB565ToRGBA(uint16(row[i])<<8|uint16(row[i+1])))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+package ui_test
+
+import (
+	"image/color"
+	"testing"
+
+	ui "github.com/itohio/tinygui"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRectIntersectUnion(t *testing.T) {
+	a := ui.Rect{X: 0, Y: 0, W: 10, H: 10}
+	b := ui.Rect{X: 5, Y: 6, W: 10, H: 10}
+
+	require.Equal(t, ui.Rect{X: 5, Y: 6, W: 5, H: 4}, a.Intersect(b))
+	require.Equal(t, ui.Rect{X: 0, Y: 0, W: 15, H: 16}, a.Union(b))
+	require.True(t, a.Overlaps(b))
+	require.True(t, a.Intersect(ui.Rect{X: 10, Y: 0, W: 5, H: 5}).Empty())
+	require.Equal(t, a, a.Union(ui.Rect{}))
+	require.True(t, a.Contains(9, 9))
+	require.False(t, a.Contains(10, 9))
+}
+
+func TestClipDisplayerTrimsDrawing(t *testing.T) {
+	white := color.RGBA{0xFF, 0xFF, 0xFF, 0xFF}
+	fb := ui.NewFramebuffer(8, 8, ui.PixelFormatRGB888)
+	clip := ui.NewClipDisplayer(fb, ui.Rect{X: 2, Y: 2, W: 4, H: 4})
+
+	clip.SetPixel(0, 0, white)
+	require.NoError(t, clip.FillRectangle(0, 0, 8, 8, white))
+	for y := int16(0); y < 8; y++ {
+		for x := int16(0); x < 8; x++ {
+			inside := x >= 2 && x < 6 && y >= 2 && y < 6
+			require.Equal(t, inside, fb.GetPixel(x, y) == white, "pixel %d,%d", x, y)
+		}
+	}
+
+	fb.FillScreen(color.RGBA{0, 0, 0, 0xFF})
+	data := make([]uint16, 16)
+	for i := range data {
+		data[i] = 0xFFFF
+	}
+	require.NoError(t, clip.DrawRGBBitmap(4, 4, data, 4, 4))
+	require.Equal(t, white, fb.GetPixel(5, 5))
+	require.Equal(t, color.RGBA{0, 0, 0, 0xFF}, fb.GetPixel(6, 6))
+	require.Equal(t, color.RGBA{0, 0, 0, 0xFF}, fb.GetPixel(3, 3))
+}
+
+func TestClipContextCloneIntersects(t *testing.T) {
+	fb := ui.NewFramebuffer(32, 32, ui.PixelFormatRGB565)
+	ctx := ui.NewClipContext(fb, 10, 10, 4, 4)
+	require.Equal(t, ui.Rect{X: 4, Y: 4, W: 10, H: 10}, ctx.Clip())
+
+	ctx.SetPos(4, 4)
+	child := ctx.Clone(nil, 20, 20)
+	clipper, ok := child.(ui.Clipper)
+	require.True(t, ok)
+	require.Equal(t, ui.Rect{X: 8, Y: 8, W: 6, H: 6}, clipper.Clip())
+}
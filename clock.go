@@ -0,0 +1,36 @@
+package ui
+
+import "time"
+
+// Clock supplies the current time. Containers, RandomContext and application
+// loops take a Clock so timeouts and periodic behaviour can be stepped in tests.
+type Clock interface {
+	Now() time.Time
+}
+
+// SystemClock reads the wall clock.
+type SystemClock struct{}
+
+func (SystemClock) Now() time.Time { return time.Now() }
+
+// ManualClock is a Clock that only moves when told to.
+type ManualClock struct {
+	now time.Time
+}
+
+// NewManualClock returns a clock frozen at start.
+func NewManualClock(start time.Time) *ManualClock {
+	return &ManualClock{now: start}
+}
+
+func (c *ManualClock) Now() time.Time { return c.now }
+
+// Advance moves the clock forward by d.
+func (c *ManualClock) Advance(d time.Duration) {
+	c.now = c.now.Add(d)
+}
+
+// Set jumps the clock to t.
+func (c *ManualClock) Set(t time.Time) {
+	c.now = t
+}
@@ -0,0 +1,48 @@
+package ui_test
+
+import (
+	"testing"
+	"time"
+
+	ui "github.com/itohio/tinygui"
+	"github.com/stretchr/testify/require"
+)
+
+func TestManualClock(t *testing.T) {
+	start := time.Unix(100, 0)
+	clock := ui.NewManualClock(start)
+	require.Equal(t, start, clock.Now())
+
+	clock.Advance(time.Second)
+	require.Equal(t, start.Add(time.Second), clock.Now())
+
+	clock.Set(start)
+	require.Equal(t, start, clock.Now())
+}
+
+func TestRandomContextShiftsOnSchedule(t *testing.T) {
+	clock := ui.NewManualClock(time.Unix(0, 0))
+	fb := ui.NewFramebuffer(200, 200, ui.PixelFormatMono)
+	ctx := ui.NewRandomContext(fb, time.Minute, 10, 10, ui.WithRandomClock(clock))
+
+	clock.Advance(time.Minute - time.Second)
+	ctx.Clone(nil, 10, 10)
+	x, y := ctx.Start()
+	require.Equal(t, int16(0), x)
+	require.Equal(t, int16(0), y)
+
+	moved := false
+	for i := 0; i < 5 && !moved; i++ {
+		clock.Advance(time.Minute)
+		ctx.Clone(nil, 10, 10)
+		x, y = ctx.Start()
+		moved = x != 0 || y != 0
+	}
+	require.True(t, moved)
+
+	clock.Advance(time.Minute - time.Second)
+	ctx.Clone(nil, 10, 10)
+	nx, ny := ctx.Start()
+	require.Equal(t, x, nx)
+	require.Equal(t, y, ny)
+}
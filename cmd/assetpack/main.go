@@ -0,0 +1,129 @@
+// Command assetpack packs a directory of PNG, JPEG and GIF images into one
+// generated Go file for the assets package.
+//
+//	assetpack [-format rgb565] [-compress raw] [-dither] [-key RRGGBB]
+//	          [-pkg icons] [-o icons.go] media
+//
+// Every image is named by its path below the directory without extension,
+// e.g. "food" or "status/pump", and converted like png2bin does, in one of
+// the rgb565, rgb332, palette, gray4 or gray1 formats. The output declares an
+// assets.ID constant per image in name order, e.g. Food and StatusPump, and a
+// Pack variable registered in init. Identical palettes and pixel data are
+// stored once.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/fs"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/itohio/tinygui/cmd/internal/imgconv"
+	"github.com/itohio/tinygui/codec"
+)
+
+func main() {
+	var opts imgconv.Options
+	flag.StringVar(&opts.Format, "format", "rgb565", "pixel format: rgb565, rgb332, palette, gray4 or gray1")
+	compress := flag.String("compress", "raw", "pixel compression: raw, rle or lz")
+	flag.BoolVar(&opts.Dither, "dither", false, "dither when reducing colours (rgb332, palette, gray4, gray1)")
+	key := flag.String("key", "", "transparency colour key as RRGGBB")
+	pkg := flag.String("pkg", "icons", "package name of the generated file")
+	out := flag.String("o", "", "output Go file (stdout when empty)")
+	flag.Parse()
+
+	if flag.NArg() != 1 {
+		log.Fatal("usage: assetpack [flags] DIR")
+	}
+	var err error
+	if opts.Compress, err = codec.ParseFormat(*compress); err != nil {
+		log.Fatal(err)
+	}
+	if *key != "" {
+		c, err := imgconv.ParseColor(*key)
+		if err != nil {
+			log.Fatal(err)
+		}
+		opts.Key = &c
+	}
+	if err := run(flag.Arg(0), *out, *pkg, opts); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func run(dir, out, pkg string, opts imgconv.Options) error {
+	switch opts.Format {
+	case "rgb565", "rgb332", "palette", "gray4", "gray1":
+	default:
+		return fmt.Errorf("format %q cannot be packed", opts.Format)
+	}
+	paths, err := images(dir)
+	if err != nil {
+		return err
+	}
+	if len(paths) == 0 {
+		return fmt.Errorf("%s: no images", dir)
+	}
+
+	p := newPacker()
+	idents := map[string]string{}
+	for _, path := range paths {
+		rel, _ := filepath.Rel(dir, path)
+		name := strings.TrimSuffix(filepath.ToSlash(rel), filepath.Ext(rel))
+		ident := imgconv.Identifier(name)
+		switch ident {
+		case "":
+			return fmt.Errorf("%s has no letters or digits to name its ID", rel)
+		case packVar:
+			return fmt.Errorf("%s maps to %s, which names the generated pack; rename the file", rel, ident)
+		}
+		if other, ok := idents[ident]; ok {
+			return fmt.Errorf("%s and %s both map to %s", other, rel, ident)
+		}
+		idents[ident] = rel
+
+		img, err := imgconv.Load(path, opts.Key)
+		if err != nil {
+			return err
+		}
+		a, err := imgconv.Convert(img, opts)
+		if err != nil {
+			return fmt.Errorf("%s: %w", path, err)
+		}
+		p.add(name, ident, a, opts.Compress)
+	}
+
+	src, err := p.generate(filepath.Base(filepath.Clean(dir)), pkg)
+	if err != nil {
+		return err
+	}
+	if out == "" {
+		_, err = os.Stdout.Write(src)
+		return err
+	}
+	return os.WriteFile(out, src, 0o644)
+}
+
+// images returns the image files below dir sorted by path, so asset IDs only
+// change when files are added, removed or renamed.
+func images(dir string) ([]string, error) {
+	var paths []string
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		switch strings.ToLower(filepath.Ext(path)) {
+		case ".png", ".jpg", ".jpeg", ".gif":
+			paths = append(paths, path)
+		}
+		return nil
+	})
+	sort.Slice(paths, func(i, j int) bool {
+		return filepath.ToSlash(paths[i]) < filepath.ToSlash(paths[j])
+	})
+	return paths, err
+}
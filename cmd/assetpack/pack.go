@@ -0,0 +1,174 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"image/color"
+	"sort"
+
+	ui "github.com/itohio/tinygui"
+	"github.com/itohio/tinygui/cmd/internal/imgconv"
+	"github.com/itohio/tinygui/codec"
+)
+
+// packVar names the generated assets.Pack variable.
+const packVar = "Pack"
+
+// entry mirrors assets.Entry for one converted image.
+type entry struct {
+	name, ident    string
+	w, h           int
+	bpp            int
+	codec          codec.Format
+	palette        int
+	offset, length int
+	key            *color.RGBA
+}
+
+// packer collects converted images into shared blobs, storing identical
+// palettes and pixel data once.
+type packer struct {
+	entries  []entry
+	palettes [][]color.RGBA
+	pixels   []uint16
+	data     []byte
+
+	paletteAt map[string]int
+	pixelsAt  map[string]int
+	dataAt    map[string]int
+}
+
+func newPacker() *packer {
+	return &packer{paletteAt: map[string]int{}, pixelsAt: map[string]int{}, dataAt: map[string]int{}}
+}
+
+func (p *packer) add(name, ident string, a *imgconv.Asset, compress codec.Format) {
+	e := entry{name: name, ident: ident, w: a.W, h: a.H, bpp: a.Bpp, codec: compress, key: a.Key}
+	if a.Words != nil {
+		e.offset, e.length = p.words(a.Words), len(a.Words)
+	} else {
+		e.offset, e.length = p.bytes(a.Bytes), len(a.Bytes)
+	}
+	if a.Bpp > 0 {
+		palette := a.Palette
+		if palette == nil {
+			// rgb332 indexes the fixed RGB332 palette.
+			rgb332 := ui.NewRGB332Palette()
+			for i := 0; i < rgb332.Len(); i++ {
+				palette = append(palette, rgb332.Color(i))
+			}
+		}
+		e.palette = p.palette(palette)
+	}
+	p.entries = append(p.entries, e)
+}
+
+func (p *packer) words(v []uint16) int {
+	k := fmt.Sprint(v)
+	if at, ok := p.pixelsAt[k]; ok {
+		return at
+	}
+	at := len(p.pixels)
+	p.pixels = append(p.pixels, v...)
+	p.pixelsAt[k] = at
+	return at
+}
+
+func (p *packer) bytes(v []byte) int {
+	k := string(v)
+	if at, ok := p.dataAt[k]; ok {
+		return at
+	}
+	at := len(p.data)
+	p.data = append(p.data, v...)
+	p.dataAt[k] = at
+	return at
+}
+
+func (p *packer) palette(v []color.RGBA) int {
+	k := fmt.Sprint(v)
+	if at, ok := p.paletteAt[k]; ok {
+		return at
+	}
+	at := len(p.palettes)
+	p.palettes = append(p.palettes, v)
+	p.paletteAt[k] = at
+	return at
+}
+
+// generate writes the pack as Go source with entries sorted by name, as
+// assets.Pack.Find expects.
+func (p *packer) generate(source, pkg string) ([]byte, error) {
+	sort.Slice(p.entries, func(i, j int) bool { return p.entries[i].name < p.entries[j].name })
+	compressed, keyed := false, false
+	for _, e := range p.entries {
+		compressed = compressed || e.codec != codec.Raw
+		keyed = keyed || e.key != nil
+	}
+
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "// Code generated by assetpack from %s; DO NOT EDIT.\n\npackage %s\n\nimport (\n", source, pkg)
+	if len(p.palettes) > 0 || keyed {
+		b.WriteString("\"image/color\"\n\n")
+	}
+	if len(p.palettes) > 0 {
+		b.WriteString("ui \"github.com/itohio/tinygui\"\n")
+	}
+	b.WriteString("\"github.com/itohio/tinygui/assets\"\n")
+	if compressed {
+		b.WriteString("\"github.com/itohio/tinygui/codec\"\n")
+	}
+	b.WriteString(")\n\n")
+
+	fmt.Fprintf(&b, "// IDs of the assets in %s, in name order.\nconst (\n", packVar)
+	for i, e := range p.entries {
+		if i == 0 {
+			fmt.Fprintf(&b, "%s assets.ID = iota\n", e.ident)
+			continue
+		}
+		fmt.Fprintf(&b, "%s\n", e.ident)
+	}
+	b.WriteString(")\n\n")
+
+	fmt.Fprintf(&b, "// %s holds %d images from %s, registered with the assets package in init.\n", packVar, len(p.entries), source)
+	fmt.Fprintf(&b, "var %s = &assets.Pack{\nEntries: []assets.Entry{\n", packVar)
+	for _, e := range p.entries {
+		fmt.Fprintf(&b, "{Name: %q, W: %d, H: %d", e.name, e.w, e.h)
+		if e.bpp > 0 {
+			fmt.Fprintf(&b, ", Kind: assets.Indexed, Bpp: %d, Palette: %d", e.bpp, e.palette)
+		}
+		if e.codec != codec.Raw {
+			fmt.Fprintf(&b, ", Codec: codec.%s", map[codec.Format]string{codec.RLE: "RLE", codec.LZ: "LZ"}[e.codec])
+		}
+		fmt.Fprintf(&b, ", Offset: %d, Length: %d", e.offset, e.length)
+		if e.key != nil {
+			fmt.Fprintf(&b, ", Key: %s", imgconv.RGBALiteral(*e.key))
+		}
+		b.WriteString("},\n")
+	}
+	b.WriteString("},\n")
+	if len(p.palettes) > 0 {
+		b.WriteString("Palettes: []*ui.Palette{\n")
+		for _, palette := range p.palettes {
+			b.WriteString("ui.NewPalette(\n")
+			for _, c := range palette {
+				fmt.Fprintf(&b, "%s,\n", imgconv.RGBALiteral(c))
+			}
+			b.WriteString("),\n")
+		}
+		b.WriteString("},\n")
+	}
+	if len(p.pixels) > 0 {
+		b.WriteString("Pixels: []uint16{")
+		imgconv.WriteWords(&b, p.pixels)
+		b.WriteString("},\n")
+	}
+	if len(p.data) > 0 {
+		b.WriteString("Data: []byte{")
+		imgconv.WriteBytes(&b, p.data)
+		b.WriteString("},\n")
+	}
+	fmt.Fprintf(&b, "}\n\nfunc init() {\nassets.Register(%s)\n}\n", packVar)
+	return format.Source(b.Bytes())
+}
@@ -0,0 +1,167 @@
+package main
+
+import (
+	"go/format"
+	"image"
+	"image/color"
+	"image/png"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	ui "github.com/itohio/tinygui"
+	"github.com/itohio/tinygui/assets"
+	"github.com/itohio/tinygui/cmd/internal/imgconv"
+	"github.com/itohio/tinygui/codec"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	red  = color.NRGBA{0xFF, 0, 0, 0xFF}
+	blue = color.NRGBA{0, 0, 0xFF, 0xFF}
+)
+
+// writePNG saves a w×h image filled row by row with colors as name below dir.
+func writePNG(t *testing.T, dir, name string, w, h int, colors ...color.NRGBA) *image.NRGBA {
+	t.Helper()
+	img := image.NewNRGBA(image.Rect(0, 0, w, h))
+	for i := 0; i < w*h; i++ {
+		img.SetNRGBA(i%w, i/w, colors[i%len(colors)])
+	}
+	path := filepath.Join(dir, name)
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+	f, err := os.Create(path)
+	require.NoError(t, err)
+	defer f.Close()
+	require.NoError(t, png.Encode(f, img))
+	return img
+}
+
+// toPack builds the assets.Pack that p generates.
+func toPack(p *packer) *assets.Pack {
+	pack := &assets.Pack{Pixels: p.pixels, Data: p.data}
+	for _, e := range p.entries {
+		entry := assets.Entry{
+			Name: e.name, W: uint16(e.w), H: uint16(e.h), Bpp: uint8(e.bpp), Codec: e.codec,
+			Palette: uint16(e.palette), Offset: uint32(e.offset), Length: uint32(e.length),
+		}
+		if e.bpp > 0 {
+			entry.Kind = assets.Indexed
+		}
+		if e.key != nil {
+			entry.Key = *e.key
+		}
+		pack.Entries = append(pack.Entries, entry)
+	}
+	for _, palette := range p.palettes {
+		pack.Palettes = append(pack.Palettes, ui.NewPalette(palette...))
+	}
+	return pack
+}
+
+// drawn draws src onto a framebuffer and returns its pixels in RGB565.
+func drawn(t *testing.T, src ui.ImageSource) []uint16 {
+	t.Helper()
+	require.NotNil(t, src)
+	w, h := src.Size()
+	fb := ui.NewFramebuffer(int16(w), int16(h), ui.PixelFormatRGB565)
+	require.NoError(t, ui.DrawImage(fb, 0, 0, src))
+	out := make([]uint16, 0, int(w)*int(h))
+	for y := int16(0); y < int16(h); y++ {
+		for x := int16(0); x < int16(w); x++ {
+			out = append(out, ui.RGBATo565(fb.GetPixel(x, y)))
+		}
+	}
+	return out
+}
+
+// want565 returns the pixels of img in RGB565.
+func want565(img *image.NRGBA) []uint16 {
+	out := make([]uint16, 0, len(img.Pix)/4)
+	for i := 0; i < len(img.Pix); i += 4 {
+		out = append(out, ui.RGBATo565(color.RGBA{img.Pix[i], img.Pix[i+1], img.Pix[i+2], 0xFF}))
+	}
+	return out
+}
+
+func TestPackRoundTrips(t *testing.T) {
+	dir := t.TempDir()
+	imgs := map[string]*image.NRGBA{
+		"water-drop":  writePNG(t, dir, "water-drop.png", 4, 2, red, blue),
+		"copy":        writePNG(t, dir, "copy.png", 4, 2, red, blue),
+		"status/pump": writePNG(t, dir, "status/pump.png", 3, 3, blue, blue, red),
+	}
+	paths, err := images(dir)
+	require.NoError(t, err)
+	require.Len(t, paths, 3)
+
+	for _, pixels := range []string{"rgb565", "rgb332", "palette"} {
+		for _, compress := range []codec.Format{codec.Raw, codec.RLE, codec.LZ} {
+			opts := imgconv.Options{Format: pixels, Compress: compress}
+			p := newPacker()
+			for _, path := range paths {
+				rel, _ := filepath.Rel(dir, path)
+				name := strings.TrimSuffix(filepath.ToSlash(rel), ".png")
+				img, err := imgconv.Load(path, nil)
+				require.NoError(t, err)
+				a, err := imgconv.Convert(img, opts)
+				require.NoError(t, err)
+				p.add(name, imgconv.Identifier(name), a, compress)
+			}
+			_, err := p.generate("media", "icons")
+			require.NoError(t, err)
+
+			require.Equal(t, p.entries[0].offset, p.entries[2].offset, "identical images share their data")
+			pack := toPack(p)
+			for name, img := range imgs {
+				id, ok := pack.Find(name)
+				require.True(t, ok, name)
+				require.Equal(t, want565(img), drawn(t, pack.Image(id)), "%s %s %s", pixels, compress, name)
+			}
+		}
+	}
+}
+
+func TestRun(t *testing.T) {
+	dir := t.TempDir()
+	writePNG(t, dir, "water-drop.png", 4, 2, red, blue)
+	writePNG(t, dir, "alarm.png", 4, 2, blue)
+	writePNG(t, dir, "status/pump.png", 3, 3, blue, red)
+	key := color.RGBA{0xFF, 0, 0xFF, 0xFF}
+	for _, opts := range []imgconv.Options{
+		{Format: "rgb565"},
+		{Format: "rgb565", Compress: codec.RLE},
+		{Format: "rgb332", Key: &key},
+		{Format: "palette", Compress: codec.LZ, Key: &key},
+		{Format: "gray4"},
+		{Format: "gray1", Dither: true},
+	} {
+		out := filepath.Join(t.TempDir(), "icons.go")
+		require.NoError(t, run(dir, out, "icons", opts), opts.Format)
+		src, err := os.ReadFile(out)
+		require.NoError(t, err)
+		formatted, err := format.Source(src)
+		require.NoError(t, err)
+		require.Equal(t, string(formatted), string(src), "%s output is gofmt clean", opts.Format)
+		require.Regexp(t, `(?s)Alarm assets\.ID = iota\s+StatusPump\s+WaterDrop\s+\)`, string(src), "IDs follow name order")
+		require.Contains(t, string(src), "assets.Register(Pack)")
+	}
+
+	require.Error(t, run(dir, "", "icons", imgconv.Options{Format: "rgb565le"}))
+	require.Error(t, run(t.TempDir(), "", "icons", imgconv.Options{Format: "rgb565"}), "no images")
+}
+
+func TestRunRejectsClashingNames(t *testing.T) {
+	for _, names := range [][]string{
+		{"a-b.png", "a_b.png"},
+		{"pack.png"},
+		{"--.png"},
+	} {
+		dir := t.TempDir()
+		for _, name := range names {
+			writePNG(t, dir, name, 1, 1, red)
+		}
+		require.Error(t, run(dir, "", "icons", imgconv.Options{Format: "rgb565"}), "%v", names)
+	}
+}
@@ -0,0 +1,138 @@
+// Command glyphcheck reports the runes of a string table that a font chain
+// cannot draw, so missing glyphs show up at build time rather than on the
+// device.
+//
+// Fonts are named by -fonts and searched in order like a text.Chain. Inputs
+// ending in .csv are read as i18ngen tables: the header row and ID column are
+// skipped and plural forms are split on '|'. Any other file is checked line
+// by line.
+//
+//	glyphcheck -fonts tomthumb,symbols strings.csv
+//
+// The exit status is 1 when a rune is missing.
+package main
+
+import (
+	"bufio"
+	"encoding/csv"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/itohio/tinygui/text"
+	"tinygo.org/x/tinyfont"
+	"tinygo.org/x/tinyfont/freemono"
+	"tinygo.org/x/tinyfont/freesans"
+	"tinygo.org/x/tinyfont/proggy"
+)
+
+var fonts = map[string]tinyfont.Fonter{
+	"tomthumb":  &tinyfont.TomThumb,
+	"org01":     &tinyfont.Org01,
+	"picopixel": &tinyfont.Picopixel,
+	"tiny3x3":   &tinyfont.Tiny3x3a2pt7b,
+	"proggy":    &proggy.TinySZ8pt7b,
+	"freemono9": &freemono.Regular9pt7b,
+	"freesans9": &freesans.Regular9pt7b,
+	"symbols":   &text.Symbols,
+}
+
+func main() {
+	names := flag.String("fonts", "tomthumb,symbols", "comma separated font chain: "+fontNames())
+	flag.Parse()
+	if flag.NArg() == 0 {
+		log.Fatal("usage: glyphcheck [-fonts a,b] FILE...")
+	}
+	chain, err := chainOf(*names)
+	if err != nil {
+		log.Fatal(err)
+	}
+	missing := false
+	for _, path := range flag.Args() {
+		n, err := check(path, chain, os.Stdout)
+		if err != nil {
+			log.Fatal(err)
+		}
+		missing = missing || n > 0
+	}
+	if missing {
+		os.Exit(1)
+	}
+}
+
+func fontNames() string {
+	names := make([]string, 0, len(fonts))
+	for name := range fonts {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return strings.Join(names, ", ")
+}
+
+func chainOf(names string) (*text.Chain, error) {
+	var chain []tinyfont.Fonter
+	for _, name := range strings.Split(names, ",") {
+		f, ok := fonts[strings.TrimSpace(name)]
+		if !ok {
+			return nil, fmt.Errorf("unknown font %q (have %s)", name, fontNames())
+		}
+		chain = append(chain, f)
+	}
+	return text.NewChain(chain...), nil
+}
+
+// check prints every line of path with runes chain lacks and returns how many
+// lines it printed.
+func check(path string, chain *text.Chain, w io.Writer) (int, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+
+	count := 0
+	report := func(line int, s string) {
+		missing := text.Missing(chain, s, nil)
+		if len(missing) == 0 {
+			return
+		}
+		count++
+		fmt.Fprintf(w, "%s:%d: missing", path, line)
+		for _, r := range missing {
+			fmt.Fprintf(w, " %q (%U)", r, r)
+		}
+		fmt.Fprintln(w)
+	}
+
+	if strings.EqualFold(filepath.Ext(path), ".csv") {
+		cr := csv.NewReader(f)
+		cr.Comment = '#'
+		cr.FieldsPerRecord = -1
+		for row := 0; ; row++ {
+			record, err := cr.Read()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				return count, err
+			}
+			if row == 0 || len(record) < 2 {
+				continue
+			}
+			line, _ := cr.FieldPos(0)
+			report(line, strings.Join(record[1:], "|"))
+		}
+		return count, nil
+	}
+
+	scanner := bufio.NewScanner(f)
+	for line := 1; scanner.Scan(); line++ {
+		report(line, scanner.Text())
+	}
+	return count, scanner.Err()
+}
@@ -0,0 +1,70 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func write(t *testing.T, name, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+	return path
+}
+
+func TestCheckCSV(t *testing.T) {
+	chain, err := chainOf("tomthumb, symbols")
+	require.NoError(t, err)
+	// The header names a locale with a rune TomThumb lacks; it is not a
+	// translation and must not be reported.
+	path := write(t, "strings.csv", `id,en,lietuvių
+# comments are skipped too: ų
+Pump,Pump ▲,Siurblys
+Items,{n} item|{n} items,{n} elementas|{n} elementų
+Hot,"Hot
+naïve",Karšta ž
+`)
+	var out bytes.Buffer
+	n, err := check(path, chain, &out)
+	require.NoError(t, err)
+	require.Equal(t, 2, n)
+	require.Equal(t, path+":4: missing 'ų' (U+0173)\n"+
+		path+":5: missing 'ï' (U+00EF) 'š' (U+0161) 'ž' (U+017E)\n", out.String(),
+		"later plural forms are checked and rows report the line they start on")
+}
+
+func TestCheckText(t *testing.T) {
+	chain, err := chainOf("tomthumb")
+	require.NoError(t, err)
+	path := write(t, "notes.txt", "all ascii\n▲ up\n\nok\nnaïve ▲\n")
+	var out bytes.Buffer
+	n, err := check(path, chain, &out)
+	require.NoError(t, err)
+	require.Equal(t, 2, n)
+	require.Equal(t, path+":2: missing '▲' (U+25B2)\n"+
+		path+":5: missing 'ï' (U+00EF) '▲' (U+25B2)\n", out.String())
+
+	out.Reset()
+	chain, err = chainOf("tomthumb,symbols")
+	require.NoError(t, err)
+	n, err = check(write(t, "arrows.txt", "▲ up\n▼ down\n"), chain, &out)
+	require.NoError(t, err)
+	require.Zero(t, n, "a covered file exits cleanly")
+	require.Empty(t, out.String())
+}
+
+func TestCheckErrors(t *testing.T) {
+	_, err := chainOf("tomthumb,comic")
+	require.Error(t, err)
+
+	chain, err := chainOf("tomthumb")
+	require.NoError(t, err)
+	_, err = check(filepath.Join(t.TempDir(), "missing.csv"), chain, &bytes.Buffer{})
+	require.Error(t, err)
+	_, err = check(write(t, "bad.csv", "id,en\nPump,\"Pump\n"), chain, &bytes.Buffer{})
+	require.Error(t, err)
+}
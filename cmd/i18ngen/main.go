@@ -0,0 +1,194 @@
+// Command i18ngen turns a translations CSV file into Go tables for the i18n
+// package.
+//
+// The first row names the columns: a string ID column followed by one column
+// per locale tag. Every further row defines one string; plural forms are
+// separated by '|'. Lines starting with '#' are comments.
+//
+//	id,en,lt
+//	Pump,Pump,Siurblys
+//	Items,{n} item|{n} items,{n} elementas|{n} elementai|{n} elementų
+//
+// The output declares a StringID constant per row and a *i18n.Locale per
+// column, e.g. LocaleEnGB for "en-GB", registered in init with the first
+// column as the default locale. Tables whose tags differ only in case, or
+// whose IDs and locale variables clash, are rejected.
+package main
+
+import (
+	"bytes"
+	"encoding/csv"
+	"flag"
+	"fmt"
+	"go/format"
+	"go/token"
+	"io"
+	"log"
+	"os"
+	"strings"
+	"unicode"
+)
+
+func main() {
+	in := flag.String("in", "", "translations CSV file")
+	out := flag.String("o", "", "output Go file (stdout when empty)")
+	pkg := flag.String("pkg", "strings", "package name of the generated file")
+	flag.Parse()
+	if err := run(*in, *out, *pkg); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func run(in, out, pkg string) error {
+	if in == "" {
+		return fmt.Errorf("usage: i18ngen -in FILE.csv [-o FILE.go] [-pkg NAME]")
+	}
+	f, err := os.Open(in)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	src, err := generate(f, pkg)
+	if err != nil {
+		return fmt.Errorf("%s: %w", in, err)
+	}
+	if out == "" {
+		_, err = os.Stdout.Write(src)
+		return err
+	}
+	return os.WriteFile(out, src, 0o644)
+}
+
+type table struct {
+	ids     []string
+	tags    []string
+	vars    []string     // locale variable names, one per tag
+	strings [][][]string // [locale][id][form]
+}
+
+func parse(r io.Reader) (*table, error) {
+	cr := csv.NewReader(r)
+	cr.Comment = '#'
+	rows, err := cr.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 || len(rows[0]) < 2 {
+		return nil, fmt.Errorf("header needs an id column and at least one locale")
+	}
+	t := &table{tags: rows[0][1:]}
+	t.strings = make([][][]string, len(t.tags))
+	tags := map[string]string{} // variable name → tag
+	folded := map[string]string{}
+	for _, tag := range t.tags {
+		// Tags are case insensitive.
+		if other, ok := folded[strings.ToLower(tag)]; ok {
+			return nil, fmt.Errorf("locales %q and %q are the same tag", other, tag)
+		}
+		folded[strings.ToLower(tag)] = tag
+		v := localeVar(tag)
+		if other, ok := tags[v]; ok {
+			return nil, fmt.Errorf("locales %q and %q both map to %s", other, tag, v)
+		}
+		tags[v] = tag
+		t.vars = append(t.vars, v)
+	}
+	seen := map[string]bool{}
+	for n, row := range rows[1:] {
+		id := strings.TrimSpace(row[0])
+		if !token.IsIdentifier(id) || !unicode.IsUpper(rune(id[0])) {
+			return nil, fmt.Errorf("row %d: %q is not an exported Go identifier", n+2, id)
+		}
+		if seen[id] {
+			return nil, fmt.Errorf("row %d: duplicate id %s", n+2, id)
+		}
+		if tag, ok := tags[id]; ok {
+			return nil, fmt.Errorf("row %d: id %s names the variable of locale %q", n+2, id, tag)
+		}
+		seen[id] = true
+		t.ids = append(t.ids, id)
+		for i := range t.tags {
+			var forms []string
+			if cell := row[i+1]; cell != "" {
+				forms = strings.Split(cell, "|")
+			}
+			t.strings[i] = append(t.strings[i], forms)
+		}
+	}
+	return t, nil
+}
+
+func generate(r io.Reader, pkg string) ([]byte, error) {
+	t, err := parse(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "// Code generated by i18ngen; DO NOT EDIT.\n\npackage %s\n\n", pkg)
+	b.WriteString("import \"github.com/itohio/tinygui/i18n\"\n\n")
+
+	b.WriteString("const (\n")
+	for i, id := range t.ids {
+		if i == 0 {
+			fmt.Fprintf(&b, "%s i18n.StringID = iota\n", id)
+			continue
+		}
+		fmt.Fprintf(&b, "%s\n", id)
+	}
+	b.WriteString(")\n\n")
+
+	for i, tag := range t.tags {
+		fmt.Fprintf(&b, "// %s holds the %q translations.\n", t.vars[i], tag)
+		fmt.Fprintf(&b, "var %s = &i18n.Locale{\nTag: %q,\nStrings: []string{\n", t.vars[i], tag)
+		plural := false
+		for _, forms := range t.strings[i] {
+			first := ""
+			if len(forms) > 0 {
+				first = forms[0]
+			}
+			fmt.Fprintf(&b, "%q,\n", first)
+			plural = plural || len(forms) > 1
+		}
+		b.WriteString("},\n")
+		if plural {
+			b.WriteString("Plurals: [][]string{\n")
+			for _, forms := range t.strings[i] {
+				if len(forms) < 2 {
+					b.WriteString("nil,\n")
+					continue
+				}
+				b.WriteString("{")
+				for _, form := range forms {
+					fmt.Fprintf(&b, "%q, ", form)
+				}
+				b.WriteString("},\n")
+			}
+			b.WriteString("},\n")
+		}
+		b.WriteString("}\n\n")
+	}
+
+	fmt.Fprintf(&b, "func init() {\ni18n.Register(%s)\n}\n", strings.Join(t.vars, ", "))
+	return format.Source(b.Bytes())
+}
+
+// localeVar turns a tag such as "en-GB" into the identifier LocaleEnGB.
+func localeVar(tag string) string {
+	var b strings.Builder
+	b.WriteString("Locale")
+	upper := true
+	for _, r := range tag {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			upper = true
+			continue
+		}
+		if upper {
+			r = unicode.ToUpper(r)
+			upper = false
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
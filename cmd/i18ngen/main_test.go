@@ -0,0 +1,98 @@
+package main
+
+import (
+	"go/format"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const translations = `# comment rows are skipped
+id,en,lt-LT
+Pump,Pump,Siurblys
+Items,{n} item|{n} items,{n} elementas|{n} elementai|{n} elementų
+Empty,Empty,
+`
+
+func TestGenerate(t *testing.T) {
+	src, err := generate(strings.NewReader(translations), "strings")
+	require.NoError(t, err)
+
+	formatted, err := format.Source(src)
+	require.NoError(t, err)
+	require.Equal(t, string(formatted), string(src), "output is gofmt clean")
+	_, err = parser.ParseFile(token.NewFileSet(), "", src, 0)
+	require.NoError(t, err)
+
+	out := string(src)
+	require.Contains(t, out, "package strings\n")
+	require.Regexp(t, `(?s)Pump\s+i18n\.StringID = iota\s+Items\s+Empty\s+\)`, out)
+	require.Contains(t, out, "var LocaleEn = &i18n.Locale{")
+	require.Contains(t, out, "var LocaleLtLT = &i18n.Locale{")
+	require.Contains(t, out, `Tag: "lt-LT",`)
+	require.Regexp(t, `(?s)Strings: \[\]string\{\s+"Siurblys",\s+"\{n\} elementas",\s+"",\s+\}`, out,
+		"plural strings keep their first form and missing ones are empty")
+	require.Regexp(t, `(?s)Plurals: \[\]\[\]string\{\s+nil,\s+\{"\{n\} item", "\{n\} items"\},\s+nil,\s+\}`, out)
+	require.Contains(t, out, `{"{n} elementas", "{n} elementai", "{n} elementų"}`)
+	require.Contains(t, out, "i18n.Register(LocaleEn, LocaleLtLT)")
+}
+
+func TestGenerateWithoutPlurals(t *testing.T) {
+	src, err := generate(strings.NewReader("id,en\nOk,OK\n"), "strings")
+	require.NoError(t, err)
+	require.NotContains(t, string(src), "Plurals")
+}
+
+func TestGenerateRejectsBadTables(t *testing.T) {
+	for name, csv := range map[string]string{
+		"no locale":          "id\nPump\n",
+		"empty":              "",
+		"unexported id":      "id,en\npump,Pump\n",
+		"invalid id":         "id,en\nPump Speed,Pump speed\n",
+		"empty id":           "id,en\n,Pump\n",
+		"duplicate id":       "id,en\nPump,Pump\nPump,Pump\n",
+		"duplicate locale":   "id,en,EN\nPump,Pump,PUMP\n",
+		"same tag":           "id,en,en\nPump,Pump,Pump\n",
+		"same variable":      "id,en-GB,en_GB\nPump,Pump,Pump\n",
+		"id names a locale":  "id,en\nLocaleEn,English\n",
+		"ragged row":         "id,en,lt\nPump,Pump\n",
+		"unterminated quote": "id,en\nPump,\"Pump\n",
+	} {
+		_, err := generate(strings.NewReader(csv), "strings")
+		require.Error(t, err, name)
+	}
+}
+
+func TestLocaleVar(t *testing.T) {
+	for tag, want := range map[string]string{
+		"en":      "LocaleEn",
+		"en-GB":   "LocaleEnGB",
+		"zh_hant": "LocaleZhHant",
+		"":        "Locale",
+	} {
+		require.Equal(t, want, localeVar(tag), tag)
+	}
+}
+
+func TestRun(t *testing.T) {
+	dir := t.TempDir()
+	in := filepath.Join(dir, "strings.csv")
+	require.NoError(t, os.WriteFile(in, []byte(translations), 0o644))
+	out := filepath.Join(dir, "strings.go")
+	require.NoError(t, run(in, out, "texts"))
+	src, err := os.ReadFile(out)
+	require.NoError(t, err)
+	require.Contains(t, string(src), "package texts\n")
+
+	require.Error(t, run("", out, "texts"))
+	require.Error(t, run(filepath.Join(dir, "missing.csv"), out, "texts"))
+	require.NoError(t, os.WriteFile(in, []byte("id,en\nPump,Pump\nPump,Pump\n"), 0o644))
+	err = run(in, out, "texts")
+	require.ErrorContains(t, err, in, "errors name the input file")
+	require.ErrorContains(t, err, "row 3")
+}
@@ -0,0 +1,263 @@
+// Package imgconv converts images into the pixel formats drawn by the ui
+// image sources, for the png2bin and assetpack commands.
+package imgconv
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"image/color/palette"
+	"image/draw"
+	_ "image/gif"
+	_ "image/jpeg"
+	_ "image/png"
+	"os"
+	"strconv"
+	"strings"
+	"unicode"
+
+	ui "github.com/itohio/tinygui"
+	"github.com/itohio/tinygui/codec"
+)
+
+// Formats lists the accepted Options.Format values.
+const Formats = "rgb565, rgb565le, rgb565be, rgb332, palette, gray4 or gray1"
+
+// Options selects the conversion of an image.
+type Options struct {
+	Format   string
+	Compress codec.Format
+	Dither   bool
+	Key      *color.RGBA
+}
+
+// Asset is a converted image.
+type Asset struct {
+	Desc string // what the pixel data holds
+	W, H int
+	Bpp  int // bits per palette index, zero for RGB565 pixels
+
+	Words   []uint16     // rgb565 pixels
+	Bytes   []byte       // every other format, and compressed data
+	Palette []color.RGBA // palette and grey formats
+	Key     *color.RGBA  // transparency key as drawn
+}
+
+// Load decodes a PNG, JPEG or GIF file and flattens it as described on
+// Flatten.
+func Load(path string, key *color.RGBA) (*image.NRGBA, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	img, _, err := image.Decode(f)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return Flatten(img, key), nil
+}
+
+// Flatten composes img over black into opaque pixels, replacing pixels less
+// than half opaque with key when one is given.
+func Flatten(img image.Image, key *color.RGBA) *image.NRGBA {
+	bounds := img.Bounds()
+	flat := image.NewNRGBA(image.Rect(0, 0, bounds.Dx(), bounds.Dy()))
+	for y := 0; y < bounds.Dy(); y++ {
+		for x := 0; x < bounds.Dx(); x++ {
+			r, g, b, a := img.At(bounds.Min.X+x, bounds.Min.Y+y).RGBA()
+			c := color.NRGBA{R: uint8(r >> 8), G: uint8(g >> 8), B: uint8(b >> 8), A: 0xFF}
+			if key != nil && a < 0x8000 {
+				c = color.NRGBA(*key)
+			}
+			flat.SetNRGBA(x, y, c)
+		}
+	}
+	return flat
+}
+
+// Convert stores img in the format and compression of opts.
+func Convert(img *image.NRGBA, opts Options) (*Asset, error) {
+	b := img.Bounds()
+	a := &Asset{W: b.Dx(), H: b.Dy(), Key: opts.Key}
+	compressible := false
+	switch opts.Format {
+	case "rgb565":
+		a.Desc = "RGB565 pixels"
+		a.Words = make([]uint16, 0, a.W*a.H)
+		for i := 0; i < len(img.Pix); i += 4 {
+			a.Words = append(a.Words, rgb565(img.Pix[i:]))
+		}
+		compressible = true
+	case "rgb565le", "rgb565be":
+		a.Desc = "RGB565 pixels, " + map[string]string{"rgb565le": "little", "rgb565be": "big"}[opts.Format] + " endian"
+		for i := 0; i < len(img.Pix); i += 4 {
+			v := rgb565(img.Pix[i:])
+			if opts.Format == "rgb565le" {
+				a.Bytes = append(a.Bytes, byte(v), byte(v>>8))
+			} else {
+				a.Bytes = append(a.Bytes, byte(v>>8), byte(v))
+			}
+		}
+	case "rgb332":
+		a.Desc = "RGB332 pixels, drawn with ui.NewRGB332Palette()"
+		a.Bpp = 8
+		p := ui.NewRGB332Palette()
+		pal := make(color.Palette, p.Len())
+		for i := range pal {
+			pal[i] = p.Color(i)
+		}
+		a.Bytes = quantize(img, pal, opts.Dither, a)
+		compressible = true
+	case "palette":
+		a.Desc = "palette indices"
+		a.Bpp = 8
+		pal := colors(img, opts.Key)
+		a.Bytes = quantize(img, pal, opts.Dither, a)
+		a.Palette = rgba(pal)
+		compressible = true
+	case "gray4", "gray1":
+		if opts.Key != nil {
+			return nil, fmt.Errorf("%s has no room for a colour key", opts.Format)
+		}
+		a.Bpp = 4
+		if opts.Format == "gray1" {
+			a.Bpp = 1
+		}
+		a.Desc = fmt.Sprintf("%dbpp grey level indices", a.Bpp)
+		levels := 1 << a.Bpp
+		pal := make(color.Palette, levels)
+		for i := range pal {
+			v := uint8(i * 255 / (levels - 1))
+			pal[i] = color.RGBA{v, v, v, 0xFF}
+		}
+		grey := image.NewGray(b)
+		draw.Draw(grey, b, img, b.Min, draw.Src)
+		a.Bytes = pack(quantize(grey, pal, opts.Dither, a), a.W, a.H, a.Bpp)
+		a.Palette = rgba(pal)
+	default:
+		return nil, fmt.Errorf("unknown format %q", opts.Format)
+	}
+
+	if opts.Compress == codec.Raw {
+		return a, nil
+	}
+	if !compressible {
+		return nil, fmt.Errorf("%s cannot be compressed", opts.Format)
+	}
+	var err error
+	if a.Words != nil {
+		a.Bytes, err = codec.Encode(opts.Compress, 2, a.Words)
+		a.Words = nil
+	} else {
+		symbols := make([]uint16, len(a.Bytes))
+		for i, v := range a.Bytes {
+			symbols[i] = uint16(v)
+		}
+		a.Bytes, err = codec.Encode(opts.Compress, 1, symbols)
+	}
+	a.Desc = opts.Compress.String() + " compressed " + a.Desc
+	return a, err
+}
+
+// ParseColor reads an RRGGBB hex colour, with or without a leading '#'.
+func ParseColor(s string) (color.RGBA, error) {
+	v, err := strconv.ParseUint(strings.TrimPrefix(s, "#"), 16, 32)
+	if err != nil || len(strings.TrimPrefix(s, "#")) != 6 {
+		return color.RGBA{}, fmt.Errorf("invalid colour %q, want RRGGBB", s)
+	}
+	return color.RGBA{R: uint8(v >> 16), G: uint8(v >> 8), B: uint8(v), A: 0xFF}, nil
+}
+
+// Identifier turns a file name such as "water-drop" into "WaterDrop".
+func Identifier(name string) string {
+	var b strings.Builder
+	upper := true
+	for _, r := range name {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			upper = true
+			continue
+		}
+		if b.Len() == 0 && unicode.IsDigit(r) {
+			b.WriteString("Img")
+		}
+		if upper {
+			r = unicode.ToUpper(r)
+			upper = false
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// RGBALiteral formats c as a Go composite literal.
+func RGBALiteral(c color.RGBA) string {
+	return fmt.Sprintf("color.RGBA{R: 0x%02x, G: 0x%02x, B: 0x%02x, A: 0x%02x}", c.R, c.G, c.B, c.A)
+}
+
+func rgb565(pix []uint8) uint16 {
+	return ui.RGBATo565(color.RGBA{R: pix[0], G: pix[1], B: pix[2], A: 0xFF})
+}
+
+// quantize maps img onto pal, one index byte per pixel, and moves the asset
+// key onto the palette colour it is drawn as.
+func quantize(img image.Image, pal color.Palette, dither bool, a *Asset) []byte {
+	b := img.Bounds()
+	dst := image.NewPaletted(image.Rect(0, 0, b.Dx(), b.Dy()), pal)
+	if dither {
+		draw.FloydSteinberg.Draw(dst, dst.Bounds(), img, b.Min)
+	} else {
+		draw.Draw(dst, dst.Bounds(), img, b.Min, draw.Src)
+	}
+	if a.Key != nil {
+		key := color.RGBAModel.Convert(pal.Convert(*a.Key)).(color.RGBA)
+		a.Key = &key
+	}
+	return dst.Pix
+}
+
+// colors returns the distinct colours of img in order of appearance, or the
+// web-safe palette plus the key when there are more than 256.
+func colors(img *image.NRGBA, key *color.RGBA) color.Palette {
+	var pal color.Palette
+	seen := map[color.NRGBA]bool{}
+	for i := 0; i < len(img.Pix); i += 4 {
+		c := color.NRGBA{img.Pix[i], img.Pix[i+1], img.Pix[i+2], 0xFF}
+		if seen[c] {
+			continue
+		}
+		seen[c] = true
+		pal = append(pal, c)
+		if len(pal) > 256 {
+			pal = append(color.Palette(nil), palette.WebSafe...)
+			if key != nil {
+				pal = append(pal, *key)
+			}
+			return pal
+		}
+	}
+	return pal
+}
+
+func rgba(pal color.Palette) []color.RGBA {
+	out := make([]color.RGBA, len(pal))
+	for i, c := range pal {
+		out[i] = color.RGBAModel.Convert(c).(color.RGBA)
+	}
+	return out
+}
+
+// pack stores one index byte per pixel at bpp bits per pixel, most
+// significant bits first, with rows starting on byte boundaries.
+func pack(indices []byte, w, h, bpp int) []byte {
+	stride := ui.IndexedStride(int16(w), uint8(bpp))
+	out := make([]byte, stride*h)
+	perByte := 8 / bpp
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			shift := 8 - bpp*(x%perByte+1)
+			out[y*stride+x/perByte] |= indices[y*w+x] << shift
+		}
+	}
+	return out
+}
@@ -0,0 +1,238 @@
+package imgconv
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/gif"
+	"image/jpeg"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	ui "github.com/itohio/tinygui"
+	"github.com/itohio/tinygui/codec"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	black = color.NRGBA{0, 0, 0, 0xFF}
+	white = color.NRGBA{0xFF, 0xFF, 0xFF, 0xFF}
+	red   = color.NRGBA{0xFF, 0, 0, 0xFF}
+	blue  = color.NRGBA{0, 0, 0xFF, 0xFF}
+)
+
+// testImage returns a w×h image filled row by row with colors.
+func testImage(w, h int, colors ...color.NRGBA) *image.NRGBA {
+	img := image.NewNRGBA(image.Rect(0, 0, w, h))
+	for i := 0; i < w*h; i++ {
+		img.SetNRGBA(i%w, i/w, colors[i%len(colors)])
+	}
+	return img
+}
+
+// want565 returns the pixels of img in RGB565.
+func want565(img *image.NRGBA) []uint16 {
+	out := make([]uint16, 0, len(img.Pix)/4)
+	for i := 0; i < len(img.Pix); i += 4 {
+		out = append(out, rgb565(img.Pix[i:]))
+	}
+	return out
+}
+
+// source wraps a converted asset in the ui or codec source that draws it.
+func source(t *testing.T, a *Asset, opts Options) ui.ImageSource {
+	t.Helper()
+	w, h := uint16(a.W), uint16(a.H)
+	var palette *ui.Palette
+	switch {
+	case a.Palette != nil:
+		palette = ui.NewPalette(a.Palette...)
+	case a.Bpp > 0:
+		palette = ui.NewRGB332Palette()
+	}
+	switch {
+	case opts.Compress != codec.Raw:
+		return codec.NewImage(w, h, opts.Compress, a.Bytes, palette)
+	case a.Words != nil:
+		return ui.NewRGB565Image(w, h, a.Words)
+	case a.Bpp > 0:
+		return ui.NewIndexedImage(w, h, uint8(a.Bpp), a.Bytes, palette)
+	}
+	t.Fatalf("%s has no source", opts.Format)
+	return nil
+}
+
+// drawn draws src onto a framebuffer and returns its pixels in RGB565.
+func drawn(t *testing.T, src ui.ImageSource) []uint16 {
+	t.Helper()
+	w, h := src.Size()
+	fb := ui.NewFramebuffer(int16(w), int16(h), ui.PixelFormatRGB565)
+	require.NoError(t, ui.DrawImage(fb, 0, 0, src))
+	out := make([]uint16, 0, int(w)*int(h))
+	for y := int16(0); y < int16(h); y++ {
+		for x := int16(0); x < int16(w); x++ {
+			out = append(out, ui.RGBATo565(fb.GetPixel(x, y)))
+		}
+	}
+	return out
+}
+
+func TestConvertRoundTrips(t *testing.T) {
+	colour := testImage(7, 3, black, white, red, blue, red)
+	mono := testImage(10, 3, black, white, white)
+	for _, tc := range []struct {
+		format string
+		img    *image.NRGBA
+	}{
+		{"rgb565", colour},
+		{"rgb332", colour},
+		{"palette", colour},
+		{"gray4", mono},
+		{"gray1", mono},
+	} {
+		for _, compress := range []codec.Format{codec.Raw, codec.RLE, codec.LZ} {
+			opts := Options{Format: tc.format, Compress: compress}
+			a, err := Convert(tc.img, opts)
+			if strings.HasPrefix(tc.format, "gray") && compress != codec.Raw {
+				require.Error(t, err, "packed grey levels cannot be compressed")
+				continue
+			}
+			require.NoError(t, err, "%s %s", tc.format, compress)
+			require.Equal(t, want565(tc.img), drawn(t, source(t, a, opts)), "%s %s", tc.format, compress)
+		}
+	}
+}
+
+func TestConvertByteOrders(t *testing.T) {
+	img := testImage(3, 1, red, blue, white)
+	le, err := Convert(img, Options{Format: "rgb565le"})
+	require.NoError(t, err)
+	be, err := Convert(img, Options{Format: "rgb565be"})
+	require.NoError(t, err)
+	require.Equal(t, []byte{0x00, 0xF8, 0x1F, 0x00, 0xFF, 0xFF}, le.Bytes)
+	require.Equal(t, []byte{0xF8, 0x00, 0x00, 0x1F, 0xFF, 0xFF}, be.Bytes)
+
+	_, err = Convert(img, Options{Format: "rgb565be", Compress: codec.LZ})
+	require.Error(t, err)
+	_, err = Convert(img, Options{Format: "bmp"})
+	require.Error(t, err)
+}
+
+func TestConvertKey(t *testing.T) {
+	src := image.NewNRGBA(image.Rect(0, 0, 2, 1))
+	src.SetNRGBA(0, 0, red)
+	magenta := color.RGBA{0xFA, 0, 0xFA, 0xFF}
+	flat := Flatten(src, &magenta)
+	require.Equal(t, color.NRGBA(magenta), flat.NRGBAAt(1, 0), "transparent pixels take the key")
+
+	a, err := Convert(flat, Options{Format: "palette", Key: &magenta})
+	require.NoError(t, err)
+	require.Equal(t, magenta, *a.Key)
+	require.Contains(t, a.Palette, magenta)
+
+	a, err = Convert(flat, Options{Format: "rgb332", Key: &magenta})
+	require.NoError(t, err)
+	require.Equal(t, color.RGBA{0xFF, 0, 0xFF, 0xFF}, *a.Key, "the key moves onto its RGB332 colour")
+	keyed := ui.NewKeyedImage(source(t, a, Options{Format: "rgb332"}), *a.Key)
+	fb := ui.NewFramebuffer(2, 1, ui.PixelFormatRGB565)
+	require.NoError(t, ui.DrawImage(fb, 0, 0, keyed))
+	require.Equal(t, color.RGBA{A: 0xFF}, fb.GetPixel(1, 0), "keyed pixels are left out")
+
+	_, err = Convert(flat, Options{Format: "gray4", Key: &magenta})
+	require.Error(t, err)
+}
+
+func TestPaletteFallsBackToWebSafe(t *testing.T) {
+	img := image.NewNRGBA(image.Rect(0, 0, 300, 1))
+	for x := 0; x < 300; x++ {
+		img.SetNRGBA(x, 0, color.NRGBA{uint8(x), uint8(x >> 8), 0, 0xFF})
+	}
+	a, err := Convert(img, Options{Format: "palette"})
+	require.NoError(t, err)
+	require.Len(t, a.Palette, 216)
+
+	key := color.RGBA{1, 2, 3, 0xFF}
+	a, err = Convert(img, Options{Format: "palette", Key: &key})
+	require.NoError(t, err)
+	require.Len(t, a.Palette, 217)
+	require.Equal(t, key, *a.Key)
+}
+
+func TestPack(t *testing.T) {
+	// Ten pixels need two bytes per row at 1bpp and five at 4bpp.
+	indices := []byte{1, 0, 1, 0, 1, 0, 1, 0, 1, 1}
+	require.Equal(t, []byte{0xAA, 0xC0}, pack(indices, 10, 1, 1))
+	require.Equal(t, []byte{0x10, 0x10, 0x10, 0x10, 0x11}, pack(indices, 10, 1, 4))
+}
+
+func TestIdentifier(t *testing.T) {
+	for in, want := range map[string]string{
+		"food":        "Food",
+		"water-drop":  "WaterDrop",
+		"my_icon.v2":  "MyIconV2",
+		"status/pump": "StatusPump",
+		"9lives":      "Img9lives",
+		".png":        "Png",
+		"--":          "",
+	} {
+		require.Equal(t, want, Identifier(in), in)
+	}
+}
+
+func TestParseColor(t *testing.T) {
+	c, err := ParseColor("#FF8000")
+	require.NoError(t, err)
+	require.Equal(t, color.RGBA{0xFF, 0x80, 0, 0xFF}, c)
+	c, err = ParseColor("00ff00")
+	require.NoError(t, err)
+	require.Equal(t, color.RGBA{0, 0xFF, 0, 0xFF}, c)
+	for _, bad := range []string{"", "fff", "12345678", "gg0000"} {
+		_, err := ParseColor(bad)
+		require.Error(t, err, bad)
+	}
+}
+
+func TestWriteWrapsEveryTwelveElements(t *testing.T) {
+	for n, lines := range map[int]int{0: 0, 1: 1, 12: 1, 13: 2, 24: 2, 25: 3} {
+		var words, byteOut bytes.Buffer
+		WriteWords(&words, make([]uint16, n))
+		WriteBytes(&byteOut, make([]byte, n))
+		for _, out := range []string{words.String(), byteOut.String()} {
+			body := strings.Split(strings.Trim(out, "\n"), "\n")
+			if lines == 0 {
+				require.Equal(t, "\n", out)
+				continue
+			}
+			require.Len(t, body, lines, "%d elements", n)
+			require.Equal(t, min(n, perLine), strings.Count(body[0], "0x"))
+			require.Equal(t, n, strings.Count(out, "0x"))
+		}
+	}
+}
+
+func TestLoadDecodesJPEGAndGIF(t *testing.T) {
+	dir := t.TempDir()
+	img := testImage(4, 2, red, blue)
+
+	var buf bytes.Buffer
+	require.NoError(t, gif.Encode(&buf, img, nil))
+	path := filepath.Join(dir, "icon.gif")
+	require.NoError(t, os.WriteFile(path, buf.Bytes(), 0o644))
+	flat, err := Load(path, nil)
+	require.NoError(t, err)
+	require.Equal(t, img.Pix, flat.Pix)
+
+	buf.Reset()
+	require.NoError(t, jpeg.Encode(&buf, img, nil))
+	path = filepath.Join(dir, "photo.jpg")
+	require.NoError(t, os.WriteFile(path, buf.Bytes(), 0o644))
+	flat, err = Load(path, nil)
+	require.NoError(t, err)
+	require.Equal(t, image.Rect(0, 0, 4, 2), flat.Bounds())
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "bad.png"), []byte("nope"), 0o644))
+	_, err = Load(filepath.Join(dir, "bad.png"), nil)
+	require.Error(t, err)
+}
@@ -0,0 +1,31 @@
+package imgconv
+
+import (
+	"fmt"
+	"io"
+)
+
+// perLine is the number of array elements written per line of source.
+const perLine = 12
+
+// WriteWords writes v as the hexadecimal elements of a Go slice literal.
+func WriteWords(w io.Writer, v []uint16) {
+	for i, x := range v {
+		if i%perLine == 0 {
+			fmt.Fprintln(w)
+		}
+		fmt.Fprintf(w, "0x%04x, ", x)
+	}
+	fmt.Fprintln(w)
+}
+
+// WriteBytes writes v as the hexadecimal elements of a Go slice literal.
+func WriteBytes(w io.Writer, v []byte) {
+	for i, x := range v {
+		if i%perLine == 0 {
+			fmt.Fprintln(w)
+		}
+		fmt.Fprintf(w, "0x%02x, ", x)
+	}
+	fmt.Fprintln(w)
+}
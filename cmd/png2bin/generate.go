@@ -0,0 +1,64 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"strings"
+
+	"github.com/itohio/tinygui/cmd/internal/imgconv"
+	"github.com/itohio/tinygui/codec"
+)
+
+// generate writes a as Go source declaring the pixel data as data.
+func generate(a *imgconv.Asset, source, data string, opts options) ([]byte, error) {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "// Code generated by png2bin from %s; DO NOT EDIT.\n\npackage %s\n\n", source, opts.pkg)
+
+	compressed := opts.Compress != codec.Raw
+	var std, mod []string
+	if a.Palette != nil || a.Key != nil {
+		std = append(std, `"image/color"`)
+	}
+	if a.Palette != nil {
+		mod = append(mod, `ui "github.com/itohio/tinygui"`)
+	}
+	if compressed {
+		mod = append(mod, `"github.com/itohio/tinygui/codec"`)
+	}
+	if len(std)+len(mod) > 0 {
+		fmt.Fprintf(&b, "import (\n%s\n\n%s\n)\n\n", strings.Join(std, "\n"), strings.Join(mod, "\n"))
+	}
+
+	fmt.Fprintf(&b, "const (\n%sWidth = %d\n%sHeight = %d\n", opts.name, a.W, opts.name, a.H)
+	if compressed {
+		fmt.Fprintf(&b, "%sFormat = codec.%s\n", opts.name, map[codec.Format]string{codec.RLE: "RLE", codec.LZ: "LZ"}[opts.Compress])
+	}
+	b.WriteString(")\n\n")
+
+	desc := a.Desc
+	if a.Palette != nil {
+		fmt.Fprintf(&b, "// %sPalette holds the colours indexed by %s.\n", opts.name, data)
+		fmt.Fprintf(&b, "var %sPalette = ui.NewPalette(\n", opts.name)
+		for _, c := range a.Palette {
+			fmt.Fprintf(&b, "%s,\n", imgconv.RGBALiteral(c))
+		}
+		b.WriteString(")\n\n")
+		desc += " into " + opts.name + "Palette"
+	}
+	if a.Key != nil {
+		fmt.Fprintf(&b, "// %sKey is the colour of transparent pixels, for ui.NewKeyedImage.\n", opts.name)
+		fmt.Fprintf(&b, "var %sKey = %s\n\n", opts.name, imgconv.RGBALiteral(*a.Key))
+	}
+
+	fmt.Fprintf(&b, "// %s holds the %d×%d image as %s.\n", data, a.W, a.H, desc)
+	if a.Words != nil {
+		fmt.Fprintf(&b, "var %s = []uint16{", data)
+		imgconv.WriteWords(&b, a.Words)
+	} else {
+		fmt.Fprintf(&b, "var %s = []byte{", data)
+		imgconv.WriteBytes(&b, a.Bytes)
+	}
+	b.WriteString("}\n")
+	return format.Source(b.Bytes())
+}
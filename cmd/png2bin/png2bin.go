@@ -1,96 +1,103 @@
+// Command png2bin compiles a PNG, JPEG or GIF image into Go source for
+// embedding in firmware.
+//
+//	png2bin [-format rgb565] [-compress raw] [-dither] [-key RRGGBB]
+//	        [-pkg icons] [-name Food] [-o food.go] food.png
+//
+// The output declares <name>Width and <name>Height constants and the pixel
+// data as <name><Ext>, e.g. FoodPng, where the name defaults to the file name
+// in CamelCase. Formats:
+//
+//   - rgb565: []uint16 pixels for ui.NewRGB565Image and widget.Bitmap16.
+//   - rgb565le, rgb565be: []byte pixels in the given byte order; big endian
+//     is what DrawRGBBitmap8 and widget.Bitmap8 expect.
+//   - rgb332: one byte per pixel, drawn with ui.NewIndexedImage and
+//     ui.NewRGB332Palette().
+//   - palette: up to 256 colours in <name>Palette and one index byte per
+//     pixel for ui.NewIndexedImage.
+//   - gray4, gray1: grey levels in <name>Palette and packed 4bpp or 1bpp rows
+//     for ui.NewIndexedImage.
+//
+// -compress rle|lz stores rgb565, rgb332 and palette pixels as codec data with
+// a <name>Format constant for codec.NewImage. -dither applies Floyd–Steinberg
+// diffusion when reducing colours. Pixels less than half opaque become the
+// -key colour, exported as <name>Key for ui.NewKeyedImage; without a key they
+// and every other pixel are composed over black.
 package main
 
 import (
+	"flag"
 	"fmt"
+	"go/token"
 	"log"
 	"os"
-	"path"
+	"path/filepath"
 	"strings"
 
-	// "tinygo.org/x/drivers/image/png"
-	"image/color"
-	"image/png"
+	"github.com/itohio/tinygui/cmd/internal/imgconv"
+	"github.com/itohio/tinygui/codec"
 )
 
+type options struct {
+	imgconv.Options
+	pkg  string
+	name string
+}
+
 func main() {
-	err := run(os.Args)
-	if err != nil {
+	var opts options
+	flag.StringVar(&opts.Format, "format", "rgb565", "pixel format: "+imgconv.Formats)
+	compress := flag.String("compress", "raw", "pixel compression: raw, rle or lz")
+	flag.BoolVar(&opts.Dither, "dither", false, "dither when reducing colours (rgb332, palette, gray4, gray1)")
+	key := flag.String("key", "", "transparency colour key as RRGGBB")
+	flag.StringVar(&opts.pkg, "pkg", "icons", "package name of the generated file")
+	flag.StringVar(&opts.name, "name", "", "identifier prefix (default: file name in CamelCase)")
+	out := flag.String("o", "", "output Go file (stdout when empty)")
+	flag.Parse()
+
+	if flag.NArg() != 1 {
+		log.Fatal("usage: png2bin [flags] FILE")
+	}
+	var err error
+	if opts.Compress, err = codec.ParseFormat(*compress); err != nil {
 		log.Fatal(err)
 	}
-}
-
-// var buf [3 * 256]uint16
-
-func RGBATo565(c color.Color) uint16 {
-	r, g, b, _ := c.RGBA()
-	return uint16((r & 0xF800) +
-		((g & 0xFC00) >> 5) +
-		((b & 0xF800) >> 11))
-}
-
-func run(args []string) error {
-	if len(args) < 2 {
-		return fmt.Errorf("usage: %s FILE", args[0])
+	if *key != "" {
+		c, err := imgconv.ParseColor(*key)
+		if err != nil {
+			log.Fatal(err)
+		}
+		opts.Key = &c
 	}
-	fname := args[1]
-	varName := path.Base(fname)
-	ext := path.Ext(fname)
-	varName = strings.Title(strings.Replace(varName, ext, "", 1))
-	ext = strings.Title(strings.Replace(ext, ".", "", 1))
-
-	if ext != "Png" && ext != "Jpg" && ext != "Jpeg" {
-		return fmt.Errorf("file %s is neither png nor a jpeg: %s", fname, ext)
+	if err := run(flag.Arg(0), *out, opts); err != nil {
+		log.Fatal(err)
 	}
+}
 
-	b, err := os.Open(fname)
+func run(in, out string, opts options) error {
+	img, err := imgconv.Load(in, opts.Key)
 	if err != nil {
 		return err
 	}
+	ext := filepath.Ext(in)
+	if opts.name == "" {
+		opts.name = imgconv.Identifier(strings.TrimSuffix(filepath.Base(in), ext))
+	}
+	if !token.IsIdentifier(opts.name) {
+		return fmt.Errorf("-name %q is not a Go identifier", opts.name)
+	}
+	asset, err := imgconv.Convert(img, opts.Options)
+	if err != nil {
+		return fmt.Errorf("%s: %w", in, err)
+	}
 
-	D := make([]uint16, 0, 40*40)
-	var W, H int16
-	// png.SetCallback(buf[:], func(data []uint16, x, y, w, h, width, height int16) {
-	// 	D = append(D, data...)
-	// 	W, H = width, height
-	// })
-	img, err := png.Decode(b)
+	src, err := generate(asset, filepath.Base(in), opts.name+imgconv.Identifier(ext), opts)
 	if err != nil {
 		return err
 	}
-	W = int16(img.Bounds().Dx())
-	H = int16(img.Bounds().Dy())
-	for y := 0; y < int(H); y++ {
-		for x := 0; x < int(W); x++ {
-			c := img.At(x, y)
-			D = append(D, RGBATo565(c))
-		}
-	}
-
-	fmt.Println("package icons")
-	fmt.Println()
-
-	fmt.Println("const (")
-	fmt.Printf("  %sWidth = %d\n", varName, W)
-	fmt.Printf("  %sHeight = %d\n", varName, H)
-	fmt.Println(")")
-	fmt.Println("var (")
-	fmt.Printf("  %s%s = []uint16 { \n", varName, ext)
-
-	max := 10
-	for i, d := range D {
-		if (i % max) == 0 {
-			fmt.Printf("    ")
-		}
-
-		fmt.Printf("%d, ", d)
-
-		if (i%max) == 0 && i != 0 {
-			fmt.Println()
-		}
+	if out == "" {
+		_, err = os.Stdout.Write(src)
+		return err
 	}
-
-	fmt.Println("  }")
-	fmt.Println(")")
-
-	return nil
+	return os.WriteFile(out, src, 0o644)
 }
@@ -0,0 +1,102 @@
+package main
+
+import (
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"image"
+	"image/color"
+	"image/png"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/itohio/tinygui/cmd/internal/imgconv"
+	"github.com/itohio/tinygui/codec"
+	"github.com/stretchr/testify/require"
+)
+
+// writePNG saves a small two colour image with a transparent pixel as
+// name in a temporary directory.
+func writePNG(t *testing.T, name string) string {
+	t.Helper()
+	img := image.NewNRGBA(image.Rect(0, 0, 5, 3))
+	for i := 1; i < 15; i++ {
+		img.SetNRGBA(i%5, i/5, color.NRGBA{uint8(i%2) * 0xFF, 0, 0xFF, 0xFF})
+	}
+	path := filepath.Join(t.TempDir(), name)
+	f, err := os.Create(path)
+	require.NoError(t, err)
+	defer f.Close()
+	require.NoError(t, png.Encode(f, img))
+	return path
+}
+
+// globals returns the names declared at the top level of src.
+func globals(t *testing.T, src []byte) map[string]bool {
+	t.Helper()
+	file, err := parser.ParseFile(token.NewFileSet(), "", src, 0)
+	require.NoError(t, err)
+	names := map[string]bool{}
+	for _, decl := range file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok {
+			continue
+		}
+		for _, spec := range gen.Specs {
+			if v, ok := spec.(*ast.ValueSpec); ok {
+				for _, name := range v.Names {
+					names[name.Name] = true
+				}
+			}
+		}
+	}
+	return names
+}
+
+func TestRun(t *testing.T) {
+	in := writePNG(t, "water-drop.png")
+	key := color.RGBA{0xFF, 0, 0xFF, 0xFF}
+	for _, tc := range []struct {
+		opts imgconv.Options
+		want []string
+	}{
+		{imgconv.Options{Format: "rgb565"}, []string{"WaterDropWidth", "WaterDropHeight", "WaterDropPng"}},
+		{imgconv.Options{Format: "rgb565", Compress: codec.LZ}, []string{"WaterDropFormat", "WaterDropPng"}},
+		{imgconv.Options{Format: "rgb565le"}, []string{"WaterDropPng"}},
+		{imgconv.Options{Format: "rgb565be", Key: &key}, []string{"WaterDropKey", "WaterDropPng"}},
+		{imgconv.Options{Format: "rgb332", Compress: codec.RLE, Dither: true}, []string{"WaterDropFormat", "WaterDropPng"}},
+		{imgconv.Options{Format: "palette", Key: &key}, []string{"WaterDropPalette", "WaterDropKey", "WaterDropPng"}},
+		{imgconv.Options{Format: "gray4"}, []string{"WaterDropPalette", "WaterDropPng"}},
+		{imgconv.Options{Format: "gray1", Dither: true}, []string{"WaterDropPalette", "WaterDropPng"}},
+	} {
+		out := filepath.Join(t.TempDir(), "out.go")
+		require.NoError(t, run(in, out, options{Options: tc.opts, pkg: "icons"}), tc.opts.Format)
+		src, err := os.ReadFile(out)
+		require.NoError(t, err)
+
+		formatted, err := format.Source(src)
+		require.NoError(t, err)
+		require.Equal(t, string(formatted), string(src), "%s output is gofmt clean", tc.opts.Format)
+		require.NotContains(t, string(src), "import ()")
+		names := globals(t, src)
+		for _, name := range tc.want {
+			require.True(t, names[name], "%s declares %s", tc.opts.Format, name)
+		}
+	}
+}
+
+func TestRunNames(t *testing.T) {
+	out := filepath.Join(t.TempDir(), "out.go")
+	require.NoError(t, run(writePNG(t, "9lives.png"), out, options{Options: imgconv.Options{Format: "rgb565"}, pkg: "icons"}))
+	src, err := os.ReadFile(out)
+	require.NoError(t, err)
+	require.True(t, globals(t, src)["Img9livesPng"])
+
+	in := writePNG(t, "food.png")
+	for _, name := range []string{"9lives", "my-icon", "func"} {
+		err := run(in, out, options{Options: imgconv.Options{Format: "rgb565"}, pkg: "icons", name: name})
+		require.Error(t, err, name)
+	}
+}
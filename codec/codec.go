@@ -0,0 +1,171 @@
+package codec
+
+import (
+	"errors"
+	"io"
+)
+
+// Format selects how pixel symbols are stored.
+type Format uint8
+
+const (
+	// Raw stores the symbols uncompressed.
+	Raw Format = iota
+	// RLE stores runs of equal symbols and literal stretches.
+	RLE
+	// LZ stores literal stretches and copies from the last 256 symbols.
+	LZ
+)
+
+var errFormat = errors.New("codec: unknown format")
+
+// String returns the lower case format name.
+func (f Format) String() string {
+	switch f {
+	case Raw:
+		return "raw"
+	case RLE:
+		return "rle"
+	case LZ:
+		return "lz"
+	}
+	return "unknown"
+}
+
+// ParseFormat returns the format named by s, as printed by String.
+func ParseFormat(s string) (Format, error) {
+	for f := Raw; f <= LZ; f++ {
+		if f.String() == s {
+			return f, nil
+		}
+	}
+	return Raw, errFormat
+}
+
+const (
+	windowSize = 256
+	maxLiteral = 128
+	maxRun     = 128
+	maxMatch   = 129
+)
+
+type packet uint8
+
+const (
+	packetNone packet = iota
+	packetLiteral
+	packetRun
+	packetMatch
+)
+
+// Decoder expands a compressed symbol stream. The zero value is empty; Reset
+// points it at data.
+type Decoder struct {
+	format Format
+	size   int
+	data   []byte
+	pos    int
+
+	kind   packet
+	count  int
+	value  uint16
+	offset uint8
+	window [windowSize]uint16
+	head   uint8
+}
+
+// NewDecoder returns a decoder over data holding symbols of size bytes, 1 for
+// palette indices and 2 for RGB565.
+func NewDecoder(format Format, size int, data []byte) *Decoder {
+	d := &Decoder{}
+	d.Reset(format, size, data)
+	return d
+}
+
+// Reset restarts the decoder on new data, reusing its window.
+func (d *Decoder) Reset(format Format, size int, data []byte) {
+	d.format, d.size, d.data, d.pos = format, size, data, 0
+	d.kind, d.count = packetNone, 0
+}
+
+// Read decodes up to len(dst) symbols into dst. It returns io.EOF once the
+// data is exhausted and io.ErrUnexpectedEOF for truncated packets.
+func (d *Decoder) Read(dst []uint16) (int, error) {
+	n := 0
+	for n < len(dst) {
+		if d.count == 0 {
+			if err := d.next(); err != nil {
+				return n, err
+			}
+		}
+		for d.count > 0 && n < len(dst) {
+			var v uint16
+			switch d.kind {
+			case packetLiteral:
+				v = d.symbol()
+			case packetRun:
+				v = d.value
+			case packetMatch:
+				// An offset of 256 wraps onto the slot about to be replaced.
+				v = d.window[d.head-d.offset]
+			}
+			d.window[d.head] = v
+			d.head++
+			dst[n] = v
+			n++
+			d.count--
+		}
+	}
+	return n, nil
+}
+
+// next reads the header of the following packet.
+func (d *Decoder) next() error {
+	if d.size != 1 && d.size != 2 {
+		return errFormat
+	}
+	if d.pos >= len(d.data) {
+		return io.EOF
+	}
+	if d.format == Raw {
+		d.kind, d.count = packetLiteral, (len(d.data)-d.pos)/d.size
+		if d.count == 0 {
+			return io.ErrUnexpectedEOF
+		}
+		return nil
+	}
+	c := d.data[d.pos]
+	d.pos++
+	switch {
+	case c < 0x80:
+		d.kind, d.count = packetLiteral, int(c)+1
+		if len(d.data)-d.pos < d.count*d.size {
+			return io.ErrUnexpectedEOF
+		}
+	case d.format == RLE:
+		if len(d.data)-d.pos < d.size {
+			return io.ErrUnexpectedEOF
+		}
+		d.kind, d.count, d.value = packetRun, int(c&0x7F)+1, d.symbol()
+	case d.format == LZ:
+		if d.pos >= len(d.data) {
+			return io.ErrUnexpectedEOF
+		}
+		d.kind, d.count, d.offset = packetMatch, int(c&0x7F)+2, d.data[d.pos]+1
+		d.pos++
+	default:
+		return errFormat
+	}
+	return nil
+}
+
+func (d *Decoder) symbol() uint16 {
+	if d.size == 1 {
+		v := d.data[d.pos]
+		d.pos++
+		return uint16(v)
+	}
+	v := uint16(d.data[d.pos])<<8 | uint16(d.data[d.pos+1])
+	d.pos += 2
+	return v
+}
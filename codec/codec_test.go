@@ -0,0 +1,118 @@
+package codec
+
+import (
+	"image/color"
+	"io"
+	"math/rand"
+	"testing"
+
+	ui "github.com/itohio/tinygui"
+	"github.com/stretchr/testify/require"
+)
+
+// icon returns w×h pixels that look like a converted icon: a mostly black
+// background with a repeating shape.
+func icon(w, h int) []uint16 {
+	pixels := make([]uint16, w*h)
+	for y := h / 4; y < h*3/4; y++ {
+		for x := w / 4; x < w*3/4; x++ {
+			pixels[y*w+x] = uint16(0xF800 + (x%3)*0x20)
+		}
+	}
+	return pixels
+}
+
+func decodeAll(t *testing.T, d *Decoder, n, chunk int) []uint16 {
+	t.Helper()
+	out := make([]uint16, 0, n)
+	buf := make([]uint16, chunk)
+	for {
+		k, err := d.Read(buf)
+		out = append(out, buf[:k]...)
+		if err == io.EOF {
+			return out
+		}
+		require.NoError(t, err)
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	noise := make([]uint16, 700)
+	for i := range noise {
+		noise[i] = uint16(rng.Intn(4))
+	}
+	inputs := [][]uint16{nil, {7}, icon(40, 40), noise}
+	for _, format := range []Format{Raw, RLE, LZ} {
+		for _, size := range []int{1, 2} {
+			for _, in := range inputs {
+				data, err := Encode(format, size, in)
+				require.NoError(t, err)
+				want := in
+				if size == 1 {
+					want = make([]uint16, len(in))
+					for i, v := range in {
+						want[i] = v & 0xFF
+					}
+				}
+				for _, chunk := range []int{1, 13, 1024} {
+					got := decodeAll(t, NewDecoder(format, size, data), len(in), chunk)
+					require.Equal(t, len(want), len(got), "%s/%d chunk %d", format, size, chunk)
+					if len(want) > 0 {
+						require.Equal(t, want, got, "%s/%d chunk %d", format, size, chunk)
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestCompressesIcons(t *testing.T) {
+	pixels := icon(40, 40)
+	raw, _ := Encode(Raw, 2, pixels)
+	rle, _ := Encode(RLE, 2, pixels)
+	lz, _ := Encode(LZ, 2, pixels)
+	require.Len(t, raw, 3200)
+	require.Less(t, len(rle), len(raw)/3)
+	require.Less(t, len(lz), len(rle))
+}
+
+func TestTruncatedData(t *testing.T) {
+	data, _ := Encode(RLE, 2, []uint16{1, 2, 3})
+	_, err := NewDecoder(RLE, 2, data[:len(data)-1]).Read(make([]uint16, 3))
+	require.ErrorIs(t, err, io.ErrUnexpectedEOF)
+
+	_, err = NewDecoder(LZ, 2, []byte{0x80}).Read(make([]uint16, 2))
+	require.ErrorIs(t, err, io.ErrUnexpectedEOF)
+
+	_, err = ParseFormat("zip")
+	require.Error(t, err)
+	f, err := ParseFormat("lz")
+	require.NoError(t, err)
+	require.Equal(t, LZ, f)
+}
+
+func TestImageStreamsRows(t *testing.T) {
+	pixels := icon(40, 40)
+	data, err := Encode(LZ, 2, pixels)
+	require.NoError(t, err)
+
+	fb := ui.NewFramebuffer(40, 40, ui.PixelFormatRGB565)
+	require.NoError(t, ui.DrawImage(fb, 0, 0, NewImage(40, 40, LZ, data, nil)))
+	for i, v := range pixels {
+		require.Equal(t, v, ui.RGBATo565(fb.GetPixel(int16(i%40), int16(i/40))), "pixel %d", i)
+	}
+
+	// Rows wider than the row buffer are split.
+	wide := make([]uint16, 1000)
+	for i := range wide {
+		wide[i] = uint16(i % 3)
+	}
+	data, _ = Encode(RLE, 1, wide)
+	palette := ui.NewPalette(color.RGBA{0, 0, 0, 0xFF}, color.RGBA{0xFF, 0, 0, 0xFF}, color.RGBA{0, 0, 0xFF, 0xFF})
+	fb = ui.NewFramebuffer(1000, 1, ui.PixelFormatRGB888)
+	require.NoError(t, ui.DrawImage(fb, 0, 0, NewImage(1000, 1, RLE, data, palette)))
+	require.Equal(t, color.RGBA{0, 0, 0xFF, 0xFF}, fb.GetPixel(998, 0))
+
+	require.Error(t, ui.DrawImage(fb, 0, 0, NewImage(1000, 2, RLE, data, palette)))
+}
@@ -0,0 +1,14 @@
+// Package codec compresses RGB565 and palette bitmaps for flash-limited
+// targets. Images are a stream of packets over pixel symbols, two bytes (big
+// endian RGB565) or one byte (palette index) each:
+//
+//   - RLE: a control byte c below 0x80 is followed by c+1 literal symbols;
+//     otherwise the next symbol repeats (c&0x7F)+1 times.
+//   - LZ: a control byte c below 0x80 is followed by c+1 literal symbols;
+//     otherwise (c&0x7F)+2 symbols are copied from o+1 symbols back, where o
+//     is the next byte. Copies may overlap, so runs are a copy from 1 back.
+//
+// Decoding streams symbols through a fixed 256 symbol window and a row
+// buffer, so drawing needs no frame-sized memory. Encode produces the data on
+// the host, e.g. from cmd/png2bin.
+package codec
@@ -0,0 +1,104 @@
+package codec
+
+// Encode compresses symbols of size bytes, 1 for palette indices and 2 for
+// RGB565, into format. It is meant for host tools and allocates freely.
+func Encode(format Format, size int, symbols []uint16) ([]byte, error) {
+	if size != 1 && size != 2 {
+		return nil, errFormat
+	}
+	e := encoder{size: size}
+	switch format {
+	case Raw:
+		for _, v := range symbols {
+			e.symbol(v)
+		}
+	case RLE:
+		e.rle(symbols)
+	case LZ:
+		e.lz(symbols)
+	default:
+		return nil, errFormat
+	}
+	return e.out, nil
+}
+
+type encoder struct {
+	size int
+	out  []byte
+}
+
+func (e *encoder) symbol(v uint16) {
+	if e.size == 1 {
+		e.out = append(e.out, byte(v))
+		return
+	}
+	e.out = append(e.out, byte(v>>8), byte(v))
+}
+
+// literal emits symbols in packets of at most maxLiteral.
+func (e *encoder) literal(symbols []uint16) {
+	for len(symbols) > 0 {
+		n := min(len(symbols), maxLiteral)
+		e.out = append(e.out, byte(n-1))
+		for _, v := range symbols[:n] {
+			e.symbol(v)
+		}
+		symbols = symbols[n:]
+	}
+}
+
+func (e *encoder) rle(symbols []uint16) {
+	start := 0
+	for i := 0; i < len(symbols); {
+		n := 1
+		for i+n < len(symbols) && n < maxRun && symbols[i+n] == symbols[i] {
+			n++
+		}
+		if n < 2 {
+			i++
+			continue
+		}
+		e.literal(symbols[start:i])
+		e.out = append(e.out, 0x80|byte(n-1))
+		e.symbol(symbols[i])
+		i += n
+		start = i
+	}
+	e.literal(symbols[start:])
+}
+
+func (e *encoder) lz(symbols []uint16) {
+	// A copy costs two bytes, so it must replace more than that in literals.
+	minMatch := 2
+	if e.size == 1 {
+		minMatch = 3
+	}
+	start := 0
+	for i := 0; i < len(symbols); {
+		length, offset := longestMatch(symbols, i)
+		if length < minMatch {
+			i++
+			continue
+		}
+		e.literal(symbols[start:i])
+		e.out = append(e.out, 0x80|byte(length-2), byte(offset-1))
+		i += length
+		start = i
+	}
+	e.literal(symbols[start:])
+}
+
+// longestMatch finds the longest copy for symbols[i:] from the window,
+// preferring the nearest offset.
+func longestMatch(symbols []uint16, i int) (length, offset int) {
+	for off := 1; off <= windowSize && off <= i; off++ {
+		n := 0
+		for i+n < len(symbols) && n < maxMatch && symbols[i+n] == symbols[i+n-off] {
+			n++
+		}
+		if n > length {
+			length, offset = n, off
+		}
+	}
+	return length, offset
+}
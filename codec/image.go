@@ -0,0 +1,91 @@
+package codec
+
+import (
+	"io"
+
+	ui "github.com/itohio/tinygui"
+)
+
+// Image is a compressed bitmap usable as a ui.ImageSource. Without a palette
+// the symbols are RGB565 pixels; with one they are palette indices.
+type Image struct {
+	W, H    uint16
+	Format  Format
+	Data    []byte
+	Palette *ui.Palette
+}
+
+var _ ui.ImageSource = (*Image)(nil)
+
+// NewImage wraps w×h compressed pixels, indexed through palette when it is
+// not nil.
+func NewImage(w, h uint16, format Format, data []byte, palette *ui.Palette) *Image {
+	return &Image{W: w, H: h, Format: format, Data: data, Palette: palette}
+}
+
+// Size returns the bitmap dimensions.
+func (img *Image) Size() (uint16, uint16) { return img.W, img.H }
+
+// decoder and rows are shared by all images: drawing is single threaded and
+// never nests, which keeps decoding free of allocations.
+var (
+	decoder Decoder
+	rows    [3 * 256]uint16
+)
+
+// Stream decodes as many whole rows as fit the shared row buffer per block,
+// or splits rows wider than the buffer.
+func (img *Image) Stream(fn ui.PixelFunc) error {
+	w, h := int(img.W), int(img.H)
+	if w == 0 || h == 0 {
+		return nil
+	}
+	size := 2
+	if img.Palette != nil {
+		size = 1
+	}
+	decoder.Reset(img.Format, size, img.Data)
+	if w > len(rows) {
+		for y := 0; y < h; y++ {
+			for x := 0; x < w; x += len(rows) {
+				n := min(len(rows), w-x)
+				if err := img.decode(rows[:n]); err != nil {
+					return err
+				}
+				if err := fn(int16(x), int16(y), int16(n), 1, rows[:n]); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}
+	step := len(rows) / w
+	for y := 0; y < h; y += step {
+		n := min(step, h-y)
+		block := rows[:n*w]
+		if err := img.decode(block); err != nil {
+			return err
+		}
+		if err := fn(0, int16(y), int16(w), int16(n), block); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// decode fills dst with pixels, mapping indices through the palette.
+func (img *Image) decode(dst []uint16) error {
+	n, err := decoder.Read(dst)
+	if n < len(dst) {
+		if err == nil || err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return err
+	}
+	if img.Palette != nil {
+		for i, v := range dst {
+			dst[i] = img.Palette.RGB565(int(v))
+		}
+	}
+	return nil
+}
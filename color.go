@@ -0,0 +1,30 @@
+package ui
+
+import "image/color"
+
+// RGBATo565 packs a colour into the RGB565 layout used by most TinyGo TFT drivers.
+func RGBATo565(c color.RGBA) uint16 {
+	return uint16(c.R&0xF8)<<8 | uint16(c.G&0xFC)<<3 | uint16(c.B)>>3
+}
+
+// RGB565ToRGBA expands an RGB565 value into an opaque colour, replicating the
+// high bits into the low bits so that full white and black survive a round trip.
+func RGB565ToRGBA(v uint16) color.RGBA {
+	r := uint8(v>>11) & 0x1F
+	g := uint8(v>>5) & 0x3F
+	b := uint8(v) & 0x1F
+	return color.RGBA{
+		R: r<<3 | r>>2,
+		G: g<<2 | g>>4,
+		B: b<<3 | b>>2,
+		A: 0xFF,
+	}
+}
+
+// Greyed desaturates c to its luma and dims it by a quarter, keeping alpha.
+// Widgets draw explicit colours through it while disabled.
+func Greyed(c color.RGBA) color.RGBA {
+	luma := (77*uint16(c.R) + 150*uint16(c.G) + 29*uint16(c.B)) >> 8
+	g := uint8(luma * 3 / 4)
+	return color.RGBA{R: g, G: g, B: g, A: c.A}
+}
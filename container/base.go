@@ -1,6 +1,7 @@
 package container
 
 import (
+	"image/color"
 	"time"
 
 	ui "github.com/itohio/tinygui"
@@ -11,10 +12,15 @@ import (
 type Base[T ui.Widget] struct {
 	ui.WidgetBase
 	layouter layout.Strategy
+	clock    ui.Clock
+	theme    *ui.Theme
 	lastTime time.Time
 	index    int
 	active   bool
 
+	focus       Focus
+	activeFocus Focus
+
 	Timeout time.Duration
 	Items   []T
 	visible map[ui.Widget]bool
@@ -23,6 +29,10 @@ type Base[T ui.Widget] struct {
 	paddingY int16
 	marginX  int16
 	marginY  int16
+
+	// invert and inverted draw FocusInvert children, reused between draws.
+	invert   *ui.FilterDisplayer
+	inverted ui.ClipContext
 }
 
 // Option configures a container at construction time.
@@ -52,6 +62,21 @@ func WithTimeout[T ui.Widget](timeout time.Duration) Option[T] {
 	}
 }
 
+// WithClock sets the clock used for the idle timeout.
+func WithClock[T ui.Widget](clock ui.Clock) Option[T] {
+	return func(c *Base[T]) {
+		c.SetClock(clock)
+	}
+}
+
+// WithTheme styles the container and all of its descendants that do not
+// provide a theme of their own.
+func WithTheme[T ui.Widget](theme *ui.Theme) Option[T] {
+	return func(c *Base[T]) {
+		c.theme = theme
+	}
+}
+
 // WithPadding sets inner padding applied before laying out children.
 func WithPadding[T ui.Widget](px, py int16) Option[T] {
 	return func(c *Base[T]) {
@@ -68,12 +93,14 @@ func WithMargin[T ui.Widget](mx, my int16) Option[T] {
 	}
 }
 
+// determineSize runs the layout over the preferred sizes of widgets and
+// returns the extent they cover. Non-zero width or height are kept.
 func determineSize[T ui.Widget](width, height uint16, l layout.Strategy, widgets []T) (uint16, uint16) {
 	ctx := ui.NewContext(nil, 0x7FFF, 0x7FFF, 0, 0)
 	var w, h uint16
 	for _, widget := range widgets {
 		x, y := ctx.DisplayPos()
-		wW, wH := widget.Size()
+		wW, wH := ui.PreferredSize(widget)
 
 		wCandidate := uint32(x) + uint32(wW)
 		hCandidate := uint32(y) + uint32(wH)
@@ -84,7 +111,7 @@ func determineSize[T ui.Widget](width, height uint16, l layout.Strategy, widgets
 			h = uint16(hCandidate)
 		}
 		if l != nil {
-			l(&ctx, widget)
+			l(&ctx, measured{wW, wH})
 		}
 	}
 	if width != 0 {
@@ -100,7 +127,7 @@ func determineSize[T ui.Widget](width, height uint16, l layout.Strategy, widgets
 func New[T ui.Widget](width, height uint16, opts ...Option[T]) *Base[T] {
 	c := &Base[T]{
 		WidgetBase: ui.NewWidgetBase(width, height),
-		lastTime:   time.Now(),
+		clock:      ui.SystemClock{},
 		index:      -1,
 		Timeout:    10 * time.Second,
 		visible:    make(map[ui.Widget]bool),
@@ -108,6 +135,7 @@ func New[T ui.Widget](width, height uint16, opts ...Option[T]) *Base[T] {
 	for _, opt := range opts {
 		opt(c)
 	}
+	c.lastTime = c.clock.Now()
 	if c.layouter != nil && (width == 0 || height == 0) {
 		w, h := determineSize(width, height, c.layouter, c.Items)
 		if width == 0 {
@@ -121,7 +149,40 @@ func New[T ui.Widget](width, height uint16, opts ...Option[T]) *Base[T] {
 }
 
 // Draw renders the container and its children using the configured layout.
+// The selected child is decorated as configured by WithFocus.
 func (c *Base[T]) Draw(ctx ui.Context) {
+	c.eachVisible(ctx, c.drawItem)
+}
+
+// DrawDirty repaints only dirty children, or the whole container when it has
+// been invalidated itself.
+func (c *Base[T]) DrawDirty(ctx ui.Context, background color.RGBA) ui.Rect {
+	if c.WidgetBase.Dirty() {
+		return ui.Redraw(ctx, c, background)
+	}
+	var area ui.Rect
+	c.eachVisible(ctx, func(localCtx ui.Context, item ui.Widget) {
+		area = area.Union(c.drawDirtyItem(localCtx, item, background))
+	})
+	return area
+}
+
+// Dirty reports whether the container or any of its children needs redrawing.
+func (c *Base[T]) Dirty() bool {
+	if c.WidgetBase.Dirty() {
+		return true
+	}
+	for _, item := range c.Items {
+		if ui.NeedsRedraw(item) {
+			return true
+		}
+	}
+	return false
+}
+
+// eachVisible lays out the children and calls draw for those inside the
+// container bounds.
+func (c *Base[T]) eachVisible(ctx ui.Context, draw func(ui.Context, ui.Widget)) {
 	innerW := int16(c.Width) - 2*c.marginX
 	innerH := int16(c.Height) - 2*c.marginY
 	if innerW < 0 {
@@ -136,13 +197,9 @@ func (c *Base[T]) Draw(ctx ui.Context) {
 	for _, item := range c.Items {
 		visible := childVisible(localCtx, item)
 		c.setVisibility(item, visible)
-		if !visible {
-			if c.layouter != nil && !c.layouter(localCtx, item) {
-				return
-			}
-			continue
+		if visible {
+			draw(localCtx, item)
 		}
-		item.Draw(localCtx)
 		if c.layouter == nil {
 			continue
 		}
@@ -157,7 +214,7 @@ func (c *Base[T]) Interact(cmd ui.UserCommand) bool {
 	if cmd == ui.IDLE {
 		return c.handleIDLE()
 	}
-	c.lastTime = time.Now()
+	c.lastTime = c.clock.Now()
 
 	if !c.active {
 		return c.handleInactive(cmd)
@@ -191,6 +248,27 @@ func (c *Base[T]) SetIndex(a int) {
 	focusTransition(prev, c.currentItem())
 }
 
+// SetClock replaces the clock used for the idle timeout and restarts it.
+func (c *Base[T]) SetClock(clock ui.Clock) {
+	if clock == nil {
+		return
+	}
+	c.clock = clock
+	c.lastTime = clock.Now()
+}
+
+// Theme returns the container's own theme, or nil when it inherits one.
+func (c *Base[T]) Theme() *ui.Theme {
+	return c.theme
+}
+
+// SetTheme swaps the theme of this subtree; nil inherits again. The
+// container is invalidated so the whole subtree is redrawn.
+func (c *Base[T]) SetTheme(theme *ui.Theme) {
+	c.theme = theme
+	c.Invalidate()
+}
+
 // Index returns the currently selected child index.
 func (c *Base[T]) Index() int {
 	return c.index
@@ -228,6 +306,7 @@ func (c *Base[T]) SetActive(a int) {
 	}
 
 	c.active = true
+	invalidate(next)
 	activationTransition(next, true)
 }
 
@@ -258,7 +337,7 @@ func (c *Base[T]) Child(index int) ui.Widget {
 }
 
 func (c *Base[T]) handleIDLE() bool {
-	if time.Since(c.lastTime) > c.Timeout {
+	if c.clock.Now().Sub(c.lastTime) >= c.Timeout {
 		c.SetIndex(-1)
 	}
 	return false
@@ -282,6 +361,7 @@ func (c *Base[T]) handleInactive(cmd ui.UserCommand) bool {
 			return false
 		}
 		c.active = true
+		invalidate(c.Items[c.index])
 	default:
 		return c.WidgetBase.Interact(cmd)
 	}
@@ -0,0 +1,51 @@
+package container
+
+import (
+	"testing"
+	"time"
+
+	ui "github.com/itohio/tinygui"
+	"github.com/itohio/tinygui/layout"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBaseIdleTimeoutUsesClock(t *testing.T) {
+	clock := ui.NewManualClock(time.Unix(0, 0))
+	c := New[ui.Widget](20, 20,
+		WithLayout[ui.Widget](layout.VList(0)),
+		WithChildren[ui.Widget](newDummyWidget(10, 10), newDummyWidget(10, 10)),
+		WithTimeout[ui.Widget](5*time.Second),
+		WithClock[ui.Widget](clock),
+	)
+	require.True(t, c.Interact(ui.NEXT))
+	require.Equal(t, 0, c.Index())
+
+	clock.Advance(5*time.Second - time.Millisecond)
+	c.Interact(ui.IDLE)
+	require.Equal(t, 0, c.Index())
+
+	clock.Advance(time.Millisecond)
+	c.Interact(ui.IDLE)
+	require.Equal(t, -1, c.Index())
+}
+
+type measuredWidget struct {
+	*dummyWidget
+	prefW, prefH uint16
+}
+
+func (m measuredWidget) MinSize() (uint16, uint16)       { return m.prefW, m.prefH }
+func (m measuredWidget) PreferredSize() (uint16, uint16) { return m.prefW, m.prefH }
+
+func TestNewSizesFromPreferredSizes(t *testing.T) {
+	c := New[ui.Widget](0, 0,
+		WithLayout[ui.Widget](layout.VList(2)),
+		WithChildren[ui.Widget](
+			measuredWidget{dummyWidget: newDummyWidget(10, 4), prefW: 30, prefH: 6},
+			newDummyWidget(12, 5),
+		),
+	)
+	w, h := c.Size()
+	require.Equal(t, uint16(30), w)
+	require.Equal(t, uint16(6+2+5), h)
+}
@@ -66,6 +66,10 @@ func NewScrollChoice(viewportW, viewportH uint16, lay layout.Strategy, widgets [
 		}
 	}))
 	choice.selector = widget.NewInteractiveSelector(widgets, selectorOpts...)
+	// Children look up the choice's enabled state through their parent.
+	for _, w := range widgets {
+		w.SetParent(choice)
+	}
 	if !cfg.enabled {
 		choice.selector.SetEnabled(false)
 	}
@@ -82,10 +86,12 @@ func NewScrollChoice(viewportW, viewportH uint16, lay layout.Strategy, widgets [
 // Enabled reports whether navigation commands are handled.
 func (c *ScrollChoice) Enabled() bool { return c.enabled }
 
-// SetEnabled toggles navigation commands.
+// SetEnabled toggles navigation commands. Children of a disabled choice are
+// drawn in their disabled state.
 func (c *ScrollChoice) SetEnabled(v bool) {
 	c.enabled = v
 	c.selector.SetEnabled(v)
+	c.Invalidate()
 }
 
 // Interact routes commands through the selector before falling back to Scroll.
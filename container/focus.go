@@ -0,0 +1,159 @@
+package container
+
+import (
+	"image/color"
+
+	ui "github.com/itohio/tinygui"
+	"github.com/itohio/tinygui/drawing"
+)
+
+// Focus selects how a container marks its selected child.
+type Focus uint8
+
+const (
+	// FocusNone draws nothing.
+	FocusNone Focus = iota
+	// FocusOutline draws a 1px rectangle along the child's edge.
+	FocusOutline
+	// FocusInvert draws the child and its background with inverted colours.
+	FocusInvert
+	// FocusCaret draws a small triangle pointing at the child from its left edge.
+	FocusCaret
+	// FocusUnderline draws a line along the child's bottom edge.
+	FocusUnderline
+)
+
+// caretSize is the height of the FocusCaret triangle.
+const caretSize = 5
+
+// WithFocus sets the decoration of the selected child and the one used while
+// it is active (being edited); both are FocusNone by default. Colours come
+// from the RoleDecoration theme style for StateFocused and StateActive.
+func WithFocus[T ui.Widget](focused, active Focus) Option[T] {
+	return func(c *Base[T]) {
+		c.focus = focused
+		c.activeFocus = active
+	}
+}
+
+// SetFocus changes the decorations at runtime and repaints the selection.
+func (c *Base[T]) SetFocus(focused, active Focus) {
+	c.focus = focused
+	c.activeFocus = active
+	invalidate(c.currentItem())
+}
+
+// decoration returns how item is decorated and the decoration style.
+func (c *Base[T]) decoration(ctx ui.Context, item ui.Widget) (Focus, ui.Style) {
+	if !item.Selected() {
+		return FocusNone, ui.Style{}
+	}
+	if fd, ok := item.(ui.FocusDrawer); ok && fd.DrawsFocus() {
+		return FocusNone, ui.Style{}
+	}
+	focus, state := c.focus, ui.StateFocused
+	switch ui.StateOf(item) {
+	case ui.StateDisabled:
+		state = ui.StateDisabled
+	case ui.StateActive:
+		focus, state = c.activeFocus, ui.StateActive
+	default:
+		if c.active && item == c.currentItem() {
+			focus, state = c.activeFocus, ui.StateActive
+		}
+	}
+	return focus, ui.ThemeOf(ctx, c).Style(ui.RoleDecoration, state)
+}
+
+// drawItem draws a child together with its focus decoration.
+func (c *Base[T]) drawItem(ctx ui.Context, item ui.Widget) {
+	focus, style := c.decoration(ctx, item)
+	if focus == FocusInvert {
+		inv := c.invertedContext(ctx, item)
+		w, h := item.Size()
+		x, y := inv.DisplayPos()
+		drawing.FillRect(inv.D(), x, y, int16(w), int16(h), invertBase(style, color.RGBA{}))
+		item.Draw(inv)
+		return
+	}
+	drawChild(ctx, item)
+	decorate(ctx, item, focus, style.Foreground)
+}
+
+// drawDirtyItem repaints a stale child together with its focus decoration.
+func (c *Base[T]) drawDirtyItem(ctx ui.Context, item ui.Widget, background color.RGBA) ui.Rect {
+	focus, style := c.decoration(ctx, item)
+	if focus == FocusInvert {
+		inv := c.invertedContext(ctx, item)
+		return ui.DrawDirty(inv, item, invertBase(style, background))
+	}
+	area := ui.DrawDirty(ctx, item, background)
+	if !area.Empty() {
+		decorate(ctx, item, focus, style.Foreground)
+	}
+	return area
+}
+
+// invertedContext returns a context at the child position drawing through a
+// colour-inverting filter. The filter and context are reused between children
+// so focused redraws do not allocate.
+func (c *Base[T]) invertedContext(ctx ui.Context, item ui.Widget) *ui.ClipContext {
+	w, h := item.Size()
+	x, y := ctx.DisplayPos()
+	area := ui.Rect{X: x, Y: y, W: int16(w), H: int16(h)}
+	if c.invert == nil {
+		c.invert = ui.NewFilterDisplayer(ctx.D(), area, invertColor)
+	} else {
+		c.invert.Reset(ctx.D(), area)
+	}
+	c.inverted = ui.NewClipContext(c.invert, w, h, x, y)
+	c.inverted.SetTheme(ui.ContextTheme(ctx))
+	return &c.inverted
+}
+
+// invertBase returns the colour that, once inverted, fills the background of
+// an inverted child: the decoration background, else the screen background,
+// else black.
+func invertBase(style ui.Style, background color.RGBA) color.RGBA {
+	if style.Background != (color.RGBA{}) {
+		return style.Background
+	}
+	if background != (color.RGBA{}) {
+		return background
+	}
+	return color.RGBA{A: 0xFF}
+}
+
+// decorate draws the outline, caret or underline over an already drawn child.
+func decorate(ctx ui.Context, item ui.Widget, focus Focus, col color.RGBA) {
+	d := ctx.D()
+	if d == nil || focus == FocusNone || focus == FocusInvert {
+		return
+	}
+	w, h := item.Size()
+	x, y := ctx.DisplayPos()
+	width, height := int16(w), int16(h)
+	switch focus {
+	case FocusOutline:
+		drawing.Rect(d, x, y, width, height, 1, col)
+	case FocusUnderline:
+		drawing.HSpan(d, x, x+width-1, y+height-1, col)
+	case FocusCaret:
+		size := min(int16(caretSize), height)
+		half := size / 2
+		mid := y + height/2
+		for i := int16(0); i <= half; i++ {
+			drawing.VSpan(d, x+i, mid-half+i, mid+half-i, col)
+		}
+	}
+}
+
+func invertColor(c color.RGBA) color.RGBA {
+	return color.RGBA{R: ^c.R, G: ^c.G, B: ^c.B, A: c.A}
+}
+
+func invalidate(w ui.Widget) {
+	if inv, ok := w.(ui.Invalidator); ok {
+		inv.Invalidate()
+	}
+}
@@ -0,0 +1,117 @@
+package container
+
+import (
+	"image/color"
+	"testing"
+
+	ui "github.com/itohio/tinygui"
+	"github.com/itohio/tinygui/layout"
+	"github.com/stretchr/testify/require"
+)
+
+// markedWidget fills its area, optionally inset, and may draw its own focus.
+type markedWidget struct {
+	fillWidget
+	inset    int16
+	ownFocus bool
+}
+
+func newMarkedWidget(w, h uint16, col color.RGBA) *markedWidget {
+	return &markedWidget{fillWidget: fillWidget{WidgetBase: ui.NewWidgetBase(w, h), col: col}}
+}
+
+func (m *markedWidget) Draw(ctx ui.Context) {
+	x, y := ctx.DisplayPos()
+	ui.FillRect(ctx.D(), x+m.inset, y+m.inset, int16(m.Width)-2*m.inset, int16(m.Height)-2*m.inset, m.col)
+}
+
+func (m *markedWidget) DrawsFocus() bool { return m.ownFocus }
+
+func TestFocusDecorations(t *testing.T) {
+	grey := color.RGBA{40, 40, 40, 255}
+	focused := color.RGBA{255, 255, 0, 255}
+	active := color.RGBA{0, 255, 255, 255}
+	theme := ui.NewTheme(ui.Style{}).
+		Set(ui.RoleDecoration, ui.StateFocused, ui.Style{Foreground: focused}).
+		Set(ui.RoleDecoration, ui.StateActive, ui.Style{Foreground: active})
+
+	first, second := newMarkedWidget(10, 6, grey), newMarkedWidget(10, 6, grey)
+	c := New[ui.Widget](10, 12,
+		WithLayout[ui.Widget](layout.VList(0)),
+		WithChildren[ui.Widget](first, second),
+		WithTheme[ui.Widget](theme),
+		WithFocus[ui.Widget](FocusOutline, FocusUnderline),
+	)
+	fb := ui.NewFramebuffer(10, 12, ui.PixelFormatRGB888)
+	ctx := ui.NewContext(fb, 10, 12, 0, 0)
+
+	c.Draw(&ctx)
+	require.Equal(t, grey, fb.GetPixel(0, 0))
+
+	c.SetIndex(0)
+	ui.DrawDirty(&ctx, c, color.RGBA{A: 255})
+	require.Equal(t, focused, fb.GetPixel(0, 0))
+	require.Equal(t, focused, fb.GetPixel(9, 5))
+	require.Equal(t, grey, fb.GetPixel(1, 1))
+
+	c.SetIndex(1)
+	ui.DrawDirty(&ctx, c, color.RGBA{A: 255})
+	require.Equal(t, grey, fb.GetPixel(0, 0), "outline is erased when focus moves")
+	require.Equal(t, focused, fb.GetPixel(0, 6))
+
+	require.False(t, c.Interact(ui.ENTER))
+	ui.DrawDirty(&ctx, c, color.RGBA{A: 255})
+	require.Equal(t, grey, fb.GetPixel(0, 6))
+	require.Equal(t, active, fb.GetPixel(0, 11))
+
+	second.ownFocus = true
+	second.Invalidate()
+	ui.DrawDirty(&ctx, c, color.RGBA{A: 255})
+	require.Equal(t, grey, fb.GetPixel(0, 11))
+}
+
+func TestFocusDefaultsToNone(t *testing.T) {
+	grey := color.RGBA{40, 40, 40, 255}
+	first, second := newMarkedWidget(10, 6, grey), newMarkedWidget(10, 6, grey)
+	c := New[ui.Widget](10, 12,
+		WithLayout[ui.Widget](layout.VList(0)),
+		WithChildren[ui.Widget](first, second),
+	)
+	fb := ui.NewFramebuffer(10, 12, ui.PixelFormatRGB888)
+	ctx := ui.NewContext(fb, 10, 12, 0, 0)
+
+	c.SetIndex(0)
+	c.Draw(&ctx)
+	c.SetActive(1)
+	ui.DrawDirty(&ctx, c, color.RGBA{A: 255})
+	for y := int16(0); y < 12; y++ {
+		for x := int16(0); x < 10; x++ {
+			require.Equal(t, grey, fb.GetPixel(x, y), "no decoration at %d,%d", x, y)
+		}
+	}
+	require.Nil(t, c.invert, "no inverting filter is set up")
+}
+
+func TestFocusInvert(t *testing.T) {
+	white := color.RGBA{255, 255, 255, 255}
+	black := color.RGBA{0, 0, 0, 255}
+	item := newMarkedWidget(6, 4, white)
+	item.inset = 1
+	c := New[ui.Widget](6, 4,
+		WithLayout[ui.Widget](layout.VList(0)),
+		WithChildren[ui.Widget](item),
+		WithFocus[ui.Widget](FocusOutline, FocusInvert),
+	)
+	fb := ui.NewFramebuffer(6, 4, ui.PixelFormatRGB888)
+	ctx := ui.NewContext(fb, 6, 4, 0, 0)
+
+	c.SetActive(0)
+	c.Draw(&ctx)
+	require.Equal(t, white, fb.GetPixel(0, 0))
+	require.Equal(t, black, fb.GetPixel(2, 2))
+
+	filter := c.invert
+	c.Draw(&ctx)
+	require.Same(t, filter, c.invert, "the inverting filter is reused")
+	require.Equal(t, white, fb.GetPixel(0, 0))
+}
@@ -4,6 +4,13 @@ import (
 	ui "github.com/itohio/tinygui"
 )
 
+// measured presents a preferred size to layout strategies.
+type measured struct {
+	w, h uint16
+}
+
+func (m measured) Size() (uint16, uint16) { return m.w, m.h }
+
 func focusTransition(from, to ui.Widget) {
 	if from != nil {
 		if handler, ok := from.(ui.FocusHandler); ok {
@@ -32,6 +39,17 @@ func activationTransition(w ui.Widget, active bool) {
 	handler.OnDeactivate()
 }
 
+// drawChild renders item. When the parent context clips, the child receives a
+// context of its own so drawing is cut at the child bounds as well.
+func drawChild(ctx ui.Context, item ui.Widget) {
+	if _, ok := ctx.(ui.Clipper); !ok {
+		item.Draw(ctx)
+		return
+	}
+	w, h := item.Size()
+	item.Draw(ctx.Clone(item, w, h))
+}
+
 func childVisible(ctx ui.Context, child ui.Widget) bool {
 	startX, startY := ctx.Start()
 	width, height := ctx.Size()
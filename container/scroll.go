@@ -1,6 +1,8 @@
 package container
 
 import (
+	"image/color"
+
 	ui "github.com/itohio/tinygui"
 	"github.com/itohio/tinygui/layout"
 )
@@ -43,6 +45,7 @@ func (s *Scroll) Scroll(dx, dy int16) bool {
 	if s.offsetX == prevX && s.offsetY == prevY {
 		return false
 	}
+	s.Invalidate()
 	s.notify(ScrollChange{
 		DX:      s.offsetX - prevX,
 		DY:      s.offsetY - prevY,
@@ -62,16 +65,23 @@ func (s *Scroll) AddObserver(observer ScrollObserver) {
 	s.observers = append(s.observers, observer)
 }
 
-// Draw renders only children that intersect the visible area.
+// Draw renders only children that intersect the visible area. Drawing is
+// clipped to the viewport so partly visible children are cut at its edge.
 func (s *Scroll) Draw(ctx ui.Context) {
-	w, h := s.Size()
-	base := ctx.Clone(s, w, h)
-	offsetCtx := &offsetContext{
-		Context: base,
-		dx:      s.offsetX,
-		dy:      s.offsetY,
+	s.eachVisible(ctx, s.drawItem)
+}
+
+// DrawDirty repaints dirty visible children. Any change of the scroll offset
+// invalidates the container, repainting the whole viewport.
+func (s *Scroll) DrawDirty(ctx ui.Context, background color.RGBA) ui.Rect {
+	if s.WidgetBase.Dirty() {
+		return ui.Redraw(ctx, s, background)
 	}
-	s.drawVisible(offsetCtx, int16(w), int16(h))
+	var area ui.Rect
+	s.eachVisible(ctx, func(localCtx ui.Context, item ui.Widget) {
+		area = area.Union(s.drawDirtyItem(localCtx, item, background))
+	})
+	return area
 }
 
 func (s *Scroll) notify(change ScrollChange) {
@@ -97,6 +107,19 @@ func (s *Scroll) refreshContentSize() {
 	s.clampOffsets()
 }
 
+// contentSize returns the area children are laid out in, never smaller than
+// the viewport so layouts keep running past its bottom edge.
+func (s *Scroll) contentSize() (uint16, uint16) {
+	w, h := s.Size()
+	if s.contentW > w {
+		w = s.contentW
+	}
+	if s.contentH > h {
+		h = s.contentH
+	}
+	return w, h
+}
+
 func (s *Scroll) clampOffsets() {
 	s.offsetX = clamp16(s.offsetX, 0, s.maxOffsetX())
 	s.offsetY = clamp16(s.offsetY, 0, s.maxOffsetY())
@@ -118,22 +141,31 @@ func (s *Scroll) maxOffsetY() int16 {
 	return int16(s.contentH - viewportH)
 }
 
-func (s *Scroll) drawVisible(ctx ui.Context, viewportW, viewportH int16) {
-	localCtx := ctx.Clone(s, uint16(viewportW), uint16(viewportH))
-	originX, originY := localCtx.Start()
+// eachVisible lays out the children in content coordinates and calls draw for
+// those intersecting the viewport, with drawing clipped to it.
+func (s *Scroll) eachVisible(ctx ui.Context, draw func(ui.Context, ui.Widget)) {
+	w, h := s.Size()
+	base := ctx.Clone(s, w, h)
+	x, y := base.Start()
+	viewport := ui.NewClipContext(base.D(), w, h, x, y)
+	if theme := ui.ContextTheme(base); theme != nil {
+		viewport.SetTheme(theme)
+	}
+	offsetCtx := &offsetContext{
+		Context: &viewport,
+		dx:      s.offsetX,
+		dy:      s.offsetY,
+	}
+
+	contentW, contentH := s.contentSize()
+	localCtx := offsetCtx.Clone(s, contentW, contentH)
 	for _, item := range s.Items {
 		itemW, itemH := item.Size()
 		displayX, displayY := localCtx.DisplayPos()
-		visible := intersectsRect(displayX, displayY, int16(itemW), int16(itemH), originX, originY, viewportW, viewportH)
+		visible := intersectsRect(displayX, displayY, int16(itemW), int16(itemH), x, y, int16(w), int16(h))
 		s.setVisibility(item, visible)
 		if visible {
-			item.Draw(localCtx)
-		}
-		if !visible {
-			if s.layouter != nil && !s.layouter(localCtx, item) {
-				break
-			}
-			continue
+			draw(localCtx, item)
 		}
 		if s.layouter != nil && !s.layouter(localCtx, item) {
 			break
@@ -152,12 +184,13 @@ func (o *offsetContext) DisplayPos() (int16, int16) {
 	return x - o.dx, y - o.dy
 }
 
+// Clone bakes the scroll offset into the child origin so nested contexts, and
+// their clip rectangles, follow the scrolled position.
 func (o *offsetContext) Clone(widget ui.Widget, W, H uint16) ui.Context {
-	return &offsetContext{
-		Context: o.Context.Clone(widget, W, H),
-		dx:      o.dx,
-		dy:      o.dy,
-	}
+	o.Context.AddPos(-o.dx, -o.dy)
+	child := o.Context.Clone(widget, W, H)
+	o.Context.AddPos(o.dx, o.dy)
+	return child
 }
 
 func clamp16(v, min, max int16) int16 {
@@ -1,6 +1,7 @@
 package container
 
 import (
+	"image/color"
 	"testing"
 
 	ui "github.com/itohio/tinygui"
@@ -55,3 +56,37 @@ func TestScrollObservers(t *testing.T) {
 	require.Equal(t, int16(10), rec.last.OffsetX)
 	require.Equal(t, int16(0), rec.last.OffsetY)
 }
+
+type fillWidget struct {
+	ui.WidgetBase
+	col color.RGBA
+}
+
+func (f *fillWidget) Draw(ctx ui.Context) {
+	x, y := ctx.DisplayPos()
+	w, h := f.Size()
+	for dy := int16(0); dy < int16(h); dy++ {
+		ui.HLine(ctx.D(), x, y+dy, int16(w), f.col)
+	}
+}
+
+func (f *fillWidget) Interact(ui.UserCommand) bool { return false }
+
+func TestScrollClipsChildrenToViewport(t *testing.T) {
+	white := color.RGBA{0xFF, 0xFF, 0xFF, 0xFF}
+	black := color.RGBA{0, 0, 0, 0xFF}
+	first := &fillWidget{WidgetBase: ui.NewWidgetBase(10, 6), col: white}
+	second := &fillWidget{WidgetBase: ui.NewWidgetBase(10, 6), col: white}
+	sc := NewScroll(10, 8, layout.VList(0), first, second)
+	require.True(t, sc.Scroll(0, 2))
+
+	fb := ui.NewFramebuffer(10, 16, ui.PixelFormatRGB888)
+	fb.FillScreen(black)
+	ctx := ui.NewContext(fb, 10, 16, 0, 0)
+	sc.Draw(&ctx)
+
+	require.Equal(t, white, fb.GetPixel(0, 0))
+	require.Equal(t, white, fb.GetPixel(0, 7))
+	require.Equal(t, black, fb.GetPixel(0, 8))
+	require.Equal(t, black, fb.GetPixel(0, 10))
+}
@@ -1,7 +1,6 @@
 package ui
 
 import (
-	"math/rand"
 	"time"
 
 	"tinygo.org/x/drivers"
@@ -10,6 +9,10 @@ import (
 var (
 	_ Context = (*ContextImpl)(nil)
 	_ Context = (*RandomContext)(nil)
+	_ Context = (*ClipContext)(nil)
+	_ Clipper = (*ClipContext)(nil)
+
+	_ ThemeProvider = (*ContextImpl)(nil)
 )
 
 // Context exposes drawing metadata for a widget. Containers clone contexts for
@@ -26,6 +29,11 @@ type Context interface {
 	Widget() Widget
 }
 
+// Clipper is implemented by contexts that confine drawing to a rectangle.
+type Clipper interface {
+	Clip() Rect
+}
+
 // ContextImpl is a concrete context carrying a displayer and positional state.
 type ContextImpl struct {
 	// D is used to display pixers
@@ -39,6 +47,9 @@ type ContextImpl struct {
 	x, y int16
 	// Coordinates to be used for the widget
 	posX, posY int16
+	// theme is inherited by clones; themeVersion counts SetTheme calls.
+	theme        *Theme
+	themeVersion uint32
 }
 
 // NewContext returns a ContextImpl rooted at (x,y) with fixed size.
@@ -53,6 +64,7 @@ func NewContext(d drivers.Displayer, w, h uint16, x, y int16) ContextImpl {
 }
 
 func (c *ContextImpl) D() drivers.Displayer     { return c.d }
+func (c *ContextImpl) Theme() *Theme            { return c.theme }
 func (c *ContextImpl) Widget() Widget           { return c.widget }
 func (c *ContextImpl) Size() (W, H uint16)      { return c.w, c.h }
 func (c *ContextImpl) Start() (X, Y int16)      { return c.x, c.y }
@@ -72,52 +84,147 @@ func (c *ContextImpl) SetPos(x, y int16) bool {
 	return true
 }
 
+// SetTheme assigns the theme inherited by every context cloned from c. Setting
+// it on the root context restyles the whole tree; Renderer notices the change
+// through Version and repaints everything.
+func (c *ContextImpl) SetTheme(theme *Theme) {
+	c.theme = theme
+	c.themeVersion++
+}
+
+// Version counts theme changes.
+func (c *ContextImpl) Version() uint32 { return c.themeVersion }
+
 // Clone creates a child context sharing the underlying displayer and adjusting
 // the drawing origin for a nested widget.
 func (c *ContextImpl) Clone(widget Widget, W, H uint16) Context {
 	x, y := c.DisplayPos()
 	ret := NewContext(c.d, W, H, x, y)
 	ret.widget = widget
+	ret.theme = c.theme
 	return &ret
 }
 
-// RandomContext implements randomly shifting context.
+// ClipContext is a ContextImpl whose displayer discards pixels outside the
+// context bounds. Clones intersect their own bounds with the parent clip, so a
+// widget drawn through a cloned context can never paint over its neighbours.
+type ClipContext struct {
+	ContextImpl
+	disp ClipDisplayer
+}
+
+// NewClipContext returns a context rooted at (x,y) that clips drawing to w*h.
+// When d is already a ClipDisplayer the two clip rectangles are intersected.
+func NewClipContext(d drivers.Displayer, w, h uint16, x, y int16) ClipContext {
+	disp := newClipDisplayer(d, Rect{X: x, Y: y, W: clampDim(int32(w)), H: clampDim(int32(h))})
+	return ClipContext{
+		ContextImpl: NewContext(disp.d, w, h, x, y),
+		disp:        disp,
+	}
+}
+
+func (c *ClipContext) D() drivers.Displayer { return &c.disp }
+func (c *ClipContext) Clip() Rect           { return c.disp.clip }
+
+// Clone creates a child context clipped to the intersection of the parent clip
+// and the child bounds.
+func (c *ClipContext) Clone(widget Widget, W, H uint16) Context {
+	x, y := c.DisplayPos()
+	ret := NewClipContext(&c.disp, W, H, x, y)
+	ret.widget = widget
+	ret.theme = c.theme
+	return &ret
+}
+
+// RandomContext implements a periodically shifting context.
 // It is especially useful for OLED displays to prevent burn-in.
 type RandomContext struct {
 	ContextImpl
 	dW, dH   int16
 	lastTime time.Time
 	interval time.Duration
+	clock    Clock
+	strategy BurnInStrategy
+	version  uint32
+}
+
+// RandomContextOption customises a RandomContext.
+type RandomContextOption func(*RandomContext)
+
+// WithRandomClock sets the clock used to schedule origin shifts.
+func WithRandomClock(clock Clock) RandomContextOption {
+	return func(c *RandomContext) {
+		if clock != nil {
+			c.clock = clock
+		}
+	}
+}
+
+// WithBurnInStrategy selects how the context protects the panel on every
+// interval (RandomJump by default).
+func WithBurnInStrategy(strategy BurnInStrategy) RandomContextOption {
+	return func(c *RandomContext) {
+		if strategy != nil {
+			c.strategy = strategy
+		}
+	}
 }
 
 // NewRandomContext returns a context that periodically moves the origin within
 // the physical display bounds to avoid static burn-in.
-func NewRandomContext(d drivers.Displayer, interval time.Duration, w, h uint16) RandomContext {
+func NewRandomContext(d drivers.Displayer, interval time.Duration, w, h uint16, opts ...RandomContextOption) RandomContext {
 	dW, dH := d.Size()
-	return RandomContext{
+	c := RandomContext{
 		ContextImpl: NewContext(d, w, h, 0, 0),
-		lastTime:    time.Now(),
 		dW:          dW,
 		dH:          dH,
 		interval:    interval,
+		clock:       SystemClock{},
+		strategy:    NewRandomJump(nil),
+	}
+	for _, opt := range opts {
+		opt(&c)
 	}
+	c.lastTime = c.clock.Now()
+	return c
 }
 
+// D returns the displayer, passed through the strategy filter when it has one.
+func (c *RandomContext) D() drivers.Displayer {
+	if filter, ok := c.strategy.(BurnInFilter); ok {
+		return filter.Filter(c.d)
+	}
+	return c.d
+}
+
+// Version counts strategy steps and theme changes. Renderer repaints
+// everything when it changes.
+func (c *RandomContext) Version() uint32 { return c.version + c.themeVersion }
+
+// Clone steps the burn-in strategy once every interval and then clones the
+// context. Movement is bounded by the displayer size.
 func (c *RandomContext) Clone(widget Widget, w, h uint16) Context {
-	if time.Since(c.lastTime) > c.interval {
-		dx := int32(c.dW - int16(c.w))
-		dy := int32(c.dH - int16(c.h))
-		if dx <= 0 {
-			dx = 1
+	now := c.clock.Now()
+	if now.Sub(c.lastTime) >= c.interval {
+		maxX := c.dW - int16(c.w)
+		maxY := c.dH - int16(c.h)
+		if maxX < 0 {
+			maxX = 0
 		}
-		if dy <= 0 {
-			dy = 1
+		if maxY < 0 {
+			maxY = 0
 		}
-
-		c.x = int16(rand.Int31n(dx))
-		c.y = int16(rand.Int31n(dy))
-		c.lastTime = time.Now()
+		c.x, c.y = c.strategy.Next(c.x, c.y, maxX, maxY)
+		if painter, ok := c.strategy.(BurnInPainter); ok {
+			painter.Paint(c.d)
+		}
+		c.version++
+		c.lastTime = now
 	}
 
-	return c.ContextImpl.Clone(widget, w, h)
+	x, y := c.DisplayPos()
+	ret := NewContext(c.D(), w, h, x, y)
+	ret.widget = widget
+	ret.theme = c.theme
+	return &ret
 }
@@ -0,0 +1,242 @@
+package ui
+
+import (
+	"image/color"
+
+	"tinygo.org/x/drivers"
+)
+
+var _ Displayer = (*DitherDisplayer)(nil)
+
+// DitherMode selects how DitherDisplayer reduces colours to grey levels.
+type DitherMode uint8
+
+const (
+	// DitherThreshold maps each pixel to the nearest level (the threshold
+	// decides black or white on 1bpp panels).
+	DitherThreshold DitherMode = iota
+	// DitherBayer applies a 4×4 ordered dither.
+	DitherBayer
+	// DitherFloydSteinberg diffuses the quantisation error to neighbouring
+	// pixels of the same fill or bitmap.
+	DitherFloydSteinberg
+)
+
+var bayer4 = [4][4]int16{
+	{0, 8, 2, 10},
+	{12, 4, 14, 6},
+	{3, 11, 1, 9},
+	{15, 7, 13, 5},
+}
+
+// ditherStrip bounds the width processed with one Floyd–Steinberg error row.
+const ditherStrip = 256
+
+// DitherDisplayer wraps a low-depth displayer (1bpp OLED, grey e-paper) and
+// reduces every colour to one of a few grey levels, emitted as opaque grey.
+type DitherDisplayer struct {
+	d         drivers.Displayer
+	mode      DitherMode
+	levels    int16
+	threshold uint8
+	errs      [2][ditherStrip + 2]int16
+}
+
+// DitherOption configures a DitherDisplayer.
+type DitherOption func(*DitherDisplayer)
+
+// WithDitherMode selects the dithering algorithm (threshold by default).
+func WithDitherMode(mode DitherMode) DitherOption {
+	return func(d *DitherDisplayer) {
+		d.mode = mode
+	}
+}
+
+// WithLevels sets the number of grey levels the panel shows (2 by default).
+func WithLevels(levels uint8) DitherOption {
+	return func(d *DitherDisplayer) {
+		if levels >= 2 {
+			d.levels = int16(levels)
+		}
+	}
+}
+
+// WithThreshold sets the luma at which 1bpp threshold mode switches to white
+// (128 by default).
+func WithThreshold(threshold uint8) DitherOption {
+	return func(d *DitherDisplayer) {
+		d.threshold = threshold
+	}
+}
+
+// NewDitherDisplayer wraps d with the configured colour reduction.
+func NewDitherDisplayer(d drivers.Displayer, opts ...DitherOption) *DitherDisplayer {
+	dd := &DitherDisplayer{d: d, levels: 2, threshold: 128}
+	for _, opt := range opts {
+		opt(dd)
+	}
+	return dd
+}
+
+// Unwrap returns the wrapped displayer.
+func (d *DitherDisplayer) Unwrap() drivers.Displayer { return d.d }
+
+func (d *DitherDisplayer) Size() (int16, int16) { return d.d.Size() }
+func (d *DitherDisplayer) Display() error       { return d.d.Display() }
+
+// SetPixel draws a single pixel. A lone pixel has no neighbours to diffuse
+// into, so Floyd–Steinberg falls back to the nearest level.
+func (d *DitherDisplayer) SetPixel(x, y int16, c color.RGBA) {
+	d.d.SetPixel(x, y, d.grey(d.quantise(x, y, luma(c))))
+}
+
+// FillRectangle fills with a solid level when the colour needs no dithering
+// and falls back to per-pixel output otherwise.
+func (d *DitherDisplayer) FillRectangle(x, y, width, height int16, c color.RGBA) error {
+	if width <= 0 || height <= 0 {
+		return nil
+	}
+	l := luma(c)
+	if d.mode == DitherThreshold || d.exact(l) {
+		FillRect(d.d, x, y, width, height, d.grey(d.quantise(x, y, l)))
+		return nil
+	}
+	d.drawRect(x, y, width, height, func(int) int16 { return l })
+	return nil
+}
+
+func (d *DitherDisplayer) FillRectangleWithBuffer(x, y, width, height int16, buffer []color.RGBA) error {
+	if width <= 0 || height <= 0 {
+		return nil
+	}
+	if int(width)*int(height) != len(buffer) {
+		return errBufferSize
+	}
+	d.drawRect(x, y, width, height, func(i int) int16 { return luma(buffer[i]) })
+	return nil
+}
+
+func (d *DitherDisplayer) FillScreen(c color.RGBA) {
+	w, h := d.d.Size()
+	_ = d.FillRectangle(0, 0, w, h, c)
+}
+
+func (d *DitherDisplayer) DrawFastHLine(x0, x1, y int16, c color.RGBA) {
+	if x0 > x1 {
+		x0, x1 = x1, x0
+	}
+	_ = d.FillRectangle(x0, y, x1-x0+1, 1, c)
+}
+
+func (d *DitherDisplayer) DrawFastVLine(x, y0, y1 int16, c color.RGBA) {
+	if y0 > y1 {
+		y0, y1 = y1, y0
+	}
+	_ = d.FillRectangle(x, y0, 1, y1-y0+1, c)
+}
+
+// DrawRGBBitmap dithers RGB565 data such as Bitmap16 icons and DrawPng output.
+func (d *DitherDisplayer) DrawRGBBitmap(x, y int16, data []uint16, w, h int16) error {
+	if w <= 0 || h <= 0 {
+		return nil
+	}
+	if int(w)*int(h) != len(data) {
+		return errBufferSize
+	}
+	d.drawRect(x, y, w, h, func(i int) int16 { return luma(RGB565ToRGBA(data[i])) })
+	return nil
+}
+
+// DrawRGBBitmap8 dithers big-endian RGB565 byte data.
+func (d *DitherDisplayer) DrawRGBBitmap8(x, y int16, data []uint8, w, h int16) error {
+	if w <= 0 || h <= 0 {
+		return nil
+	}
+	if int(w)*int(h)*2 != len(data) {
+		return errBufferSize
+	}
+	d.drawRect(x, y, w, h, func(i int) int16 {
+		return luma(RGB565ToRGBA(uint16(data[2*i])<<8 | uint16(data[2*i+1])))
+	})
+	return nil
+}
+
+// drawRect outputs a rectangle whose source luma is read by index. Error
+// diffusion runs over vertical strips of at most ditherStrip pixels.
+func (d *DitherDisplayer) drawRect(x, y, w, h int16, src func(i int) int16) {
+	if d.mode != DitherFloydSteinberg {
+		for j := int16(0); j < h; j++ {
+			for i := int16(0); i < w; i++ {
+				v := src(int(j)*int(w) + int(i))
+				d.d.SetPixel(x+i, y+j, d.grey(d.quantise(x+i, y+j, v)))
+			}
+		}
+		return
+	}
+	for strip := int16(0); strip < w; strip += ditherStrip {
+		sw := min16(ditherStrip, w-strip)
+		d.errs = [2][ditherStrip + 2]int16{}
+		cur, next := &d.errs[0], &d.errs[1]
+		for j := int16(0); j < h; j++ {
+			for i := int16(0); i < sw; i++ {
+				v := src(int(j)*int(w)+int(strip+i)) + cur[i+1]/16
+				q := d.nearest(v)
+				e := v - d.level(q)
+				cur[i+2] += e * 7
+				next[i] += e * 3
+				next[i+1] += e * 5
+				next[i+2] += e
+				d.d.SetPixel(x+strip+i, y+j, d.grey(q))
+			}
+			*cur = [ditherStrip + 2]int16{}
+			cur, next = next, cur
+		}
+	}
+}
+
+// quantise maps a luma value at (x,y) to a level index.
+func (d *DitherDisplayer) quantise(x, y int16, l int16) int16 {
+	switch d.mode {
+	case DitherBayer:
+		step := 255 / (d.levels - 1)
+		l += (bayer4[y&3][x&3]*2 - 15) * step / 32
+	case DitherThreshold:
+		if d.levels == 2 {
+			if l >= int16(d.threshold) {
+				return 1
+			}
+			return 0
+		}
+	}
+	return d.nearest(l)
+}
+
+func (d *DitherDisplayer) nearest(l int16) int16 {
+	if l <= 0 {
+		return 0
+	}
+	if l >= 255 {
+		return d.levels - 1
+	}
+	return int16((int32(l)*int32(d.levels-1) + 127) / 255)
+}
+
+// exact reports whether l already sits on a level, so no dithering is needed.
+func (d *DitherDisplayer) exact(l int16) bool {
+	return d.level(d.nearest(l)) == l
+}
+
+func (d *DitherDisplayer) grey(level int16) color.RGBA {
+	g := uint8(d.level(level))
+	return color.RGBA{g, g, g, 0xFF}
+}
+
+// level returns the luma a level index is displayed at.
+func (d *DitherDisplayer) level(q int16) int16 {
+	return int16(int32(q) * 255 / int32(d.levels-1))
+}
+
+// luma returns the perceived brightness of c in the range 0..255.
+func luma(c color.RGBA) int16 {
+	return int16((uint16(c.R)*77 + uint16(c.G)*150 + uint16(c.B)*29) >> 8)
+}
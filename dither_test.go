@@ -0,0 +1,52 @@
+package ui_test
+
+import (
+	"image/color"
+	"testing"
+
+	ui "github.com/itohio/tinygui"
+	"github.com/stretchr/testify/require"
+)
+
+func countWhite(fb *ui.Framebuffer, w, h int16) int {
+	n := 0
+	for y := int16(0); y < h; y++ {
+		for x := int16(0); x < w; x++ {
+			if fb.GetPixel(x, y).R != 0 {
+				n++
+			}
+		}
+	}
+	return n
+}
+
+func TestDitherThreshold(t *testing.T) {
+	fb := ui.NewFramebuffer(4, 1, ui.PixelFormatRGB888)
+	d := ui.NewDitherDisplayer(fb, ui.WithThreshold(100))
+
+	d.SetPixel(0, 0, color.RGBA{0, 0, 0xFF, 0xFF})
+	d.SetPixel(1, 0, color.RGBA{0xFF, 0xFF, 0, 0xFF})
+	require.Equal(t, color.RGBA{0, 0, 0, 0xFF}, fb.GetPixel(0, 0))
+	require.Equal(t, color.RGBA{0xFF, 0xFF, 0xFF, 0xFF}, fb.GetPixel(1, 0))
+}
+
+func TestDitherGreyFillProducesPattern(t *testing.T) {
+	grey := color.RGBA{0x80, 0x80, 0x80, 0xFF}
+	for _, mode := range []ui.DitherMode{ui.DitherBayer, ui.DitherFloydSteinberg} {
+		fb := ui.NewFramebuffer(8, 8, ui.PixelFormatRGB888)
+		d := ui.NewDitherDisplayer(fb, ui.WithDitherMode(mode))
+		require.NoError(t, d.FillRectangle(0, 0, 8, 8, grey))
+		white := countWhite(fb, 8, 8)
+		require.InDelta(t, 32, white, 4, "mode %d", mode)
+	}
+}
+
+func TestDitherBitmapLevels(t *testing.T) {
+	fb := ui.NewFramebuffer(4, 1, ui.PixelFormatRGB888)
+	d := ui.NewDitherDisplayer(fb, ui.WithLevels(4))
+	data := []uint16{0x0000, 0x528A, 0xAD55, 0xFFFF}
+	require.NoError(t, d.DrawRGBBitmap(0, 0, data, 4, 1))
+	for i, want := range []uint8{0, 85, 170, 255} {
+		require.Equal(t, want, fb.GetPixel(int16(i), 0).R)
+	}
+}
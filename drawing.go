@@ -2,10 +2,8 @@ package ui
 
 import (
 	"image/color"
-	"strings"
 
 	"tinygo.org/x/drivers"
-	"tinygo.org/x/drivers/image/png"
 )
 
 type BitmapDisplayer interface {
@@ -49,6 +47,20 @@ func VLine(d drivers.Displayer, x, y, h int16, c color.RGBA) {
 	}
 }
 
+// FillRect fills a rectangle, using RectangleDisplayer when available.
+func FillRect(d drivers.Displayer, x, y, w, h int16, c color.RGBA) {
+	if w <= 0 || h <= 0 {
+		return
+	}
+	if fast, ok := d.(RectangleDisplayer); ok {
+		_ = fast.FillRectangle(x, y, w, h, c)
+		return
+	}
+	for dy := int16(0); dy < h; dy++ {
+		HLine(d, x, y+dy, w, c)
+	}
+}
+
 var buffer [3 * 256]uint16
 
 // NOTE: This part does not work with tinygo version 0.23.0 windows/amd64 (using go version go1.18 and LLVM version 14.0.0)
@@ -56,21 +68,26 @@ var buffer [3 * 256]uint16
 // However, it is mostly not needed due to the fact, that raw bytes of the icons are smaller than embedded PNG files.
 // DrawPng decodes pngImage and streams pixels into the provided displayer.
 func DrawPng(d BitmapDisplayer, x0, y0 int16, pngImage string) error {
-	p := strings.NewReader(pngImage)
-	png.SetCallback(buffer[:], func(data []uint16, x, y, w, h, width, height int16) {
-		// W, H := d.Size()
-		// println(x0+x, y0+y, w, h, width, height, W, H, len(data))
-		err := d.DrawRGBBitmap(x0+x, y0+y, data[:w*h], w, h)
-		if err != nil {
-			println("DrawRGBBitmap: " + err.Error())
-		}
+	err := PNGImage(pngImage).Stream(func(x, y, w, h int16, data []uint16) error {
+		return d.DrawRGBBitmap(x0+x, y0+y, data, w, h)
 	})
-
-	// w, h := d.Size()
-	_, err := png.Decode(p)
 	if err != nil {
-		println("DrawPng Decode: " + err.Error())
+		println("DrawPng: " + err.Error())
 	}
-	// w, h = d.Size()
 	return err
 }
+
+// PngSize reads the image dimensions from the IHDR chunk of a PNG without
+// decoding it.
+func PngSize(pngImage string) (width, height uint16, ok bool) {
+	const signature = "\x89PNG\r\n\x1a\n"
+	if len(pngImage) < 24 || pngImage[:8] != signature || pngImage[12:16] != "IHDR" {
+		return 0, 0, false
+	}
+	w := uint32(pngImage[16])<<24 | uint32(pngImage[17])<<16 | uint32(pngImage[18])<<8 | uint32(pngImage[19])
+	h := uint32(pngImage[20])<<24 | uint32(pngImage[21])<<16 | uint32(pngImage[22])<<8 | uint32(pngImage[23])
+	if w > 0xFFFF || h > 0xFFFF {
+		return 0, 0, false
+	}
+	return uint16(w), uint16(h), true
+}
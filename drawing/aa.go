@@ -0,0 +1,168 @@
+package drawing
+
+import (
+	"image/color"
+	"math"
+
+	ui "github.com/itohio/tinygui"
+	"tinygo.org/x/drivers"
+)
+
+// The anti-aliased primitives below draw edge pixels with partial coverage
+// through ui.BlendPixel: they blend with the screen when the displayer can
+// read pixels back and with bg otherwise. Translucent colours (c.A < 255) are
+// honoured throughout.
+
+// LineAA draws a one pixel wide anti-aliased line (Xiaolin Wu).
+func LineAA(d drivers.Displayer, x0, y0, x1, y1 int16, c, bg color.RGBA) {
+	if x0 == x1 || y0 == y1 {
+		if x0 == x1 {
+			spanAA(d, x0, y0, y1, c, bg, true)
+		} else {
+			spanAA(d, y0, x0, x1, c, bg, false)
+		}
+		return
+	}
+	steep := abs16(y1-y0) > abs16(x1-x0)
+	if steep {
+		x0, y0 = y0, x0
+		x1, y1 = y1, x1
+	}
+	if x0 > x1 {
+		x0, x1 = x1, x0
+		y0, y1 = y1, y0
+	}
+	gradient := float32(y1-y0) / float32(x1-x0)
+	plot := func(x, y int16, cov float32) {
+		if steep {
+			x, y = y, x
+		}
+		plotAA(d, x, y, c, cov, bg)
+	}
+	for x := x0; x <= x1; x++ {
+		fy := float32(y0) + gradient*float32(x-x0)
+		iy := float32(math.Floor(float64(fy)))
+		frac := fy - iy
+		plot(x, int16(iy), 1-frac)
+		plot(x, int16(iy)+1, frac)
+	}
+}
+
+// CircleAA draws a one pixel wide anti-aliased circle outline.
+func CircleAA(d drivers.Displayer, cx, cy, r int16, c, bg color.RGBA) {
+	if r <= 0 {
+		plotAA(d, cx, cy, c, 1, bg)
+		return
+	}
+	rr := float64(r) * float64(r)
+	for x := int16(0); ; x++ {
+		fy := math.Sqrt(rr - float64(x)*float64(x))
+		iy := int16(fy)
+		if x > iy {
+			break
+		}
+		frac := float32(fy - float64(iy))
+		octants(x, iy, func(px, py int16) { plotAA(d, cx+px, cy+py, c, 1-frac, bg) })
+		octants(x, iy+1, func(px, py int16) { plotAA(d, cx+px, cy+py, c, frac, bg) })
+	}
+}
+
+// FillCircleAA fills a circle with anti-aliased edges.
+func FillCircleAA(d drivers.Displayer, cx, cy, r int16, c, bg color.RGBA) {
+	ArcAA(d, cx, cy, r, r, 0, 360, c, bg)
+}
+
+// ArcAA draws part of a ring like Arc, anti-aliasing the inner and outer
+// edges. A thickness of r or more fills the sector to the centre.
+func ArcAA(d drivers.Displayer, cx, cy, r, thickness int16, startDeg, endDeg float32, c, bg color.RGBA) {
+	if r <= 0 {
+		return
+	}
+	if thickness <= 0 {
+		thickness = 1
+	}
+	start, sweep := arcSweep(startDeg, endDeg)
+	// Coverage ramps over one pixel centred on the edges Arc uses, r+0.5 and
+	// r-thickness+0.5.
+	outer := float32(r) + 1
+	inner := float32(r - thickness)
+	for dy := -r - 1; dy <= r+1; dy++ {
+		for dx := -r - 1; dx <= r+1; dx++ {
+			if !inArc(dx, dy, start, sweep) {
+				continue
+			}
+			dist := float32(math.Sqrt(float64(dx)*float64(dx) + float64(dy)*float64(dy)))
+			cov := clampCoverage(outer - dist)
+			if thickness < r {
+				cov *= clampCoverage(dist - inner)
+			}
+			plotAA(d, cx+dx, cy+dy, c, cov, bg)
+		}
+	}
+}
+
+// plotAA draws c at (x,y) with the given coverage in [0,1].
+func plotAA(d drivers.Displayer, x, y int16, c color.RGBA, coverage float32, bg color.RGBA) {
+	if coverage <= 0 {
+		return
+	}
+	if coverage < 1 {
+		c = ui.WithCoverage(c, uint8(coverage*255+0.5))
+	}
+	ui.BlendPixel(d, x, y, c, bg)
+}
+
+// spanAA draws a fully covered horizontal (or vertical) run, using the span
+// fast paths for opaque colours.
+func spanAA(d drivers.Displayer, fixed, a0, a1 int16, c, bg color.RGBA, vertical bool) {
+	if c.A == 0xFF {
+		if vertical {
+			VSpan(d, fixed, a0, a1, c)
+		} else {
+			HSpan(d, a0, a1, fixed, c)
+		}
+		return
+	}
+	if a0 > a1 {
+		a0, a1 = a1, a0
+	}
+	for a := a0; a <= a1; a++ {
+		if vertical {
+			ui.BlendPixel(d, fixed, a, c, bg)
+		} else {
+			ui.BlendPixel(d, a, fixed, c, bg)
+		}
+	}
+}
+
+// octants calls plot once for every distinct mirror image of (x,y) across the
+// axes and diagonals.
+func octants(x, y int16, plot func(x, y int16)) {
+	quadrants(x, y, plot)
+	if x != y {
+		quadrants(y, x, plot)
+	}
+}
+
+func quadrants(x, y int16, plot func(x, y int16)) {
+	plot(x, y)
+	if x != 0 {
+		plot(-x, y)
+	}
+	if y != 0 {
+		plot(x, -y)
+		if x != 0 {
+			plot(-x, -y)
+		}
+	}
+}
+
+func clampCoverage(v float32) float32 {
+	if v < 0 {
+		return 0
+	}
+	if v > 1 {
+		return 1
+	}
+	return v
+}
@@ -0,0 +1,75 @@
+package drawing
+
+import (
+	"image/color"
+	"math"
+
+	"tinygo.org/x/drivers"
+)
+
+// Arc draws part of a ring between startDeg and endDeg. Angles are in degrees,
+// measured clockwise from the positive x axis (3 o'clock) as the screen y axis
+// points down; the arc runs clockwise from start to end. The ring spans radii
+// r-thickness+1 to r.
+func Arc(d drivers.Displayer, cx, cy, r, thickness int16, startDeg, endDeg float32, c color.RGBA) {
+	if r <= 0 {
+		return
+	}
+	if thickness <= 0 {
+		thickness = 1
+	}
+	if thickness > r {
+		thickness = r
+	}
+	start, sweep := arcSweep(startDeg, endDeg)
+	outer := int32(r)*int32(r) + int32(r)
+	inner := int32(r-thickness)*int32(r-thickness) + int32(r-thickness)
+	for dy := -r; dy <= r; dy++ {
+		run := false
+		var runStart int16
+		for dx := -r; dx <= r+1; dx++ {
+			in := false
+			if dx <= r {
+				dist := int32(dx)*int32(dx) + int32(dy)*int32(dy)
+				if dist <= outer && (thickness == r || dist > inner) {
+					in = inArc(dx, dy, start, sweep)
+				}
+			}
+			if in && !run {
+				run, runStart = true, dx
+			} else if !in && run {
+				run = false
+				HSpan(d, cx+runStart, cx+dx-1, cy+dy, c)
+			}
+		}
+	}
+}
+
+// arcSweep returns the normalised start angle and the clockwise sweep from
+// startDeg to endDeg. Equal angles a full turn apart sweep the whole circle.
+func arcSweep(startDeg, endDeg float32) (float64, float64) {
+	start := normaliseDeg(float64(startDeg))
+	sweep := normaliseDeg(float64(endDeg) - float64(startDeg))
+	if sweep == 0 && endDeg != startDeg {
+		sweep = 360
+	}
+	return start, sweep
+}
+
+// inArc reports whether the offset (dx,dy) from the centre lies within the
+// sweep.
+func inArc(dx, dy int16, start, sweep float64) bool {
+	if sweep >= 360 {
+		return true
+	}
+	angle := normaliseDeg(math.Atan2(float64(dy), float64(dx)) * 180 / math.Pi)
+	return normaliseDeg(angle-start) <= sweep
+}
+
+func normaliseDeg(a float64) float64 {
+	a = math.Mod(a, 360)
+	if a < 0 {
+		a += 360
+	}
+	return a
+}
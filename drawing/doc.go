@@ -0,0 +1,6 @@
+// Package drawing provides geometry primitives (lines, rectangles, circles,
+// ellipses, arcs and polygons) for any drivers.Displayer. Every primitive is
+// reduced to horizontal and vertical spans that use the LineDisplayer or
+// RectangleDisplayer fast paths when the driver offers them and fall back to
+// SetPixel otherwise.
+package drawing
@@ -0,0 +1,142 @@
+package drawing
+
+import (
+	"image/color"
+	"testing"
+
+	ui "github.com/itohio/tinygui"
+	"github.com/stretchr/testify/require"
+	"tinygo.org/x/drivers"
+)
+
+var white = color.RGBA{255, 255, 255, 255}
+
+// pixelOnly hides every accelerated method of the framebuffer.
+type pixelOnly struct {
+	fb *ui.Framebuffer
+}
+
+func (p pixelOnly) Size() (int16, int16)              { return p.fb.Size() }
+func (p pixelOnly) SetPixel(x, y int16, c color.RGBA) { p.fb.SetPixel(x, y, c) }
+func (p pixelOnly) Display() error                    { return nil }
+
+func newFB() *ui.Framebuffer {
+	return ui.NewFramebuffer(32, 32, ui.PixelFormatRGB888)
+}
+
+func count(fb *ui.Framebuffer) int {
+	n := 0
+	w, h := fb.Size()
+	for y := int16(0); y < h; y++ {
+		for x := int16(0); x < w; x++ {
+			if fb.GetPixel(x, y) == white {
+				n++
+			}
+		}
+	}
+	return n
+}
+
+func TestFallbackMatchesFastPath(t *testing.T) {
+	shapes := map[string]func(d drivers.Displayer){
+		"line":       func(d drivers.Displayer) { Line(d, 1, 2, 28, 19, white) },
+		"thick":      func(d drivers.Displayer) { ThickLine(d, 3, 3, 25, 20, 4, white) },
+		"polygon":    func(d drivers.Displayer) { FillPolygon(d, []Point{{2, 2}, {28, 6}, {16, 29}}, white) },
+		"roundrect":  func(d drivers.Displayer) { RoundRect(d, 2, 2, 26, 20, 6, 2, white) },
+		"froundrect": func(d drivers.Displayer) { FillRoundRect(d, 2, 2, 26, 20, 6, white) },
+		"circle":     func(d drivers.Displayer) { FillCircle(d, 16, 16, 10, white) },
+		"ellipse":    func(d drivers.Displayer) { Ellipse(d, 16, 16, 12, 6, white) },
+		"arc":        func(d drivers.Displayer) { Arc(d, 16, 16, 12, 3, 300, 60, white) },
+	}
+	for name, draw := range shapes {
+		fast, slow := newFB(), newFB()
+		draw(fast)
+		draw(pixelOnly{slow})
+		require.Equal(t, fast.Buffer(), slow.Buffer(), name)
+		require.NotZero(t, count(fast), name)
+	}
+}
+
+func TestFillCircleIsSymmetric(t *testing.T) {
+	fb := newFB()
+	FillCircle(fb, 15, 15, 7, white)
+	for y := int16(0); y <= 30; y++ {
+		for x := int16(0); x <= 30; x++ {
+			require.Equal(t, fb.GetPixel(x, y), fb.GetPixel(30-x, y))
+			require.Equal(t, fb.GetPixel(x, y), fb.GetPixel(y, x))
+		}
+	}
+	require.Equal(t, white, fb.GetPixel(15, 8))
+	require.NotEqual(t, white, fb.GetPixel(15, 7))
+	require.NotEqual(t, white, fb.GetPixel(9, 9))
+}
+
+func TestRectBorder(t *testing.T) {
+	fb := newFB()
+	Rect(fb, 2, 2, 10, 8, 2, white)
+	require.Equal(t, 2*10*2+2*4*2, count(fb))
+	require.Equal(t, white, fb.GetPixel(3, 3))
+	require.NotEqual(t, white, fb.GetPixel(4, 4))
+}
+
+func TestArcQuadrant(t *testing.T) {
+	fb := newFB()
+	// Clockwise from 3 o'clock to 6 o'clock covers the bottom-right quadrant.
+	Arc(fb, 16, 16, 10, 2, 0, 90, white)
+	require.Equal(t, white, fb.GetPixel(23, 23))
+	require.NotEqual(t, white, fb.GetPixel(9, 9))
+	require.NotEqual(t, white, fb.GetPixel(23, 9))
+	require.NotEqual(t, white, fb.GetPixel(16, 16))
+}
+
+func TestFillPolygonConcave(t *testing.T) {
+	fb := newFB()
+	// A "U" shape; the notch must stay empty.
+	FillPolygon(fb, []Point{{2, 2}, {8, 2}, {8, 20}, {20, 20}, {20, 2}, {26, 2}, {26, 26}, {2, 26}}, white)
+	require.Equal(t, white, fb.GetPixel(4, 10))
+	require.Equal(t, white, fb.GetPixel(14, 24))
+	require.NotEqual(t, white, fb.GetPixel(14, 10))
+}
+
+func TestLineAACoverage(t *testing.T) {
+	fb := newFB()
+	LineAA(fb, 0, 0, 20, 10, white, color.RGBA{})
+	// Halfway between rows both neighbours get about half the intensity.
+	a, b := fb.GetPixel(1, 0), fb.GetPixel(1, 1)
+	require.InDelta(t, 128, int(a.R), 2)
+	require.InDelta(t, 128, int(b.R), 2)
+	require.Equal(t, white, fb.GetPixel(20, 10))
+
+	straight, plain := newFB(), newFB()
+	LineAA(straight, 2, 5, 20, 5, white, color.RGBA{})
+	Line(plain, 2, 5, 20, 5, white)
+	require.Equal(t, plain.Buffer(), straight.Buffer())
+}
+
+func TestAAReadBackMatchesBackground(t *testing.T) {
+	bg := color.RGBA{0, 0, 80, 255}
+	shapes := map[string]func(d drivers.Displayer){
+		"line":   func(d drivers.Displayer) { LineAA(d, 1, 3, 29, 17, white, bg) },
+		"circle": func(d drivers.Displayer) { CircleAA(d, 16, 16, 11, white, bg) },
+		"fill":   func(d drivers.Displayer) { FillCircleAA(d, 16, 16, 9, white, bg) },
+		"arc":    func(d drivers.Displayer) { ArcAA(d, 16, 16, 12, 4, 200, 20, white, bg) },
+	}
+	for name, draw := range shapes {
+		read, blind := newFB(), newFB()
+		read.FillScreen(bg)
+		blind.FillScreen(bg)
+		draw(read)
+		draw(pixelOnly{blind})
+		require.Equal(t, read.Buffer(), blind.Buffer(), name)
+	}
+}
+
+func TestFillCircleAAEdges(t *testing.T) {
+	fb := newFB()
+	FillCircleAA(fb, 16, 16, 8, white, color.RGBA{})
+	require.Equal(t, white, fb.GetPixel(16, 16))
+	require.Equal(t, white, fb.GetPixel(16, 8))
+	edge := fb.GetPixel(22, 22)
+	require.True(t, edge.R > 0 && edge.R < 255, "edge %v", edge)
+	require.Equal(t, color.RGBA{0, 0, 0, 255}, fb.GetPixel(16, 6))
+}
@@ -0,0 +1,80 @@
+package drawing
+
+import (
+	"image/color"
+
+	"tinygo.org/x/drivers"
+)
+
+// Circle draws a one pixel wide circle outline.
+func Circle(d drivers.Displayer, cx, cy, r int16, c color.RGBA) {
+	Ellipse(d, cx, cy, r, r, c)
+}
+
+// FillCircle fills a circle.
+func FillCircle(d drivers.Displayer, cx, cy, r int16, c color.RGBA) {
+	FillEllipse(d, cx, cy, r, r, c)
+}
+
+// Ellipse draws a one pixel wide outline of an axis-aligned ellipse.
+func Ellipse(d drivers.Displayer, cx, cy, rx, ry int16, c color.RGBA) {
+	ellipse(rx, ry, func(x, y int16) {
+		d.SetPixel(cx+x, cy+y, c)
+		d.SetPixel(cx-x, cy+y, c)
+		d.SetPixel(cx+x, cy-y, c)
+		d.SetPixel(cx-x, cy-y, c)
+	})
+}
+
+// FillEllipse fills an axis-aligned ellipse with horizontal spans.
+func FillEllipse(d drivers.Displayer, cx, cy, rx, ry int16, c color.RGBA) {
+	ellipse(rx, ry, func(x, y int16) {
+		HSpan(d, cx-x, cx+x, cy+y, c)
+		if y != 0 {
+			HSpan(d, cx-x, cx+x, cy-y, c)
+		}
+	})
+}
+
+// ellipse runs the midpoint ellipse algorithm, calling plot for every point
+// of the first quadrant (x, y >= 0).
+func ellipse(rx, ry int16, plot func(x, y int16)) {
+	if rx < 0 || ry < 0 {
+		return
+	}
+	if rx == 0 || ry == 0 {
+		for x := int16(0); x <= rx; x++ {
+			plot(x, 0)
+		}
+		for y := int16(0); y <= ry; y++ {
+			plot(0, y)
+		}
+		return
+	}
+	a2, b2 := int64(rx)*int64(rx), int64(ry)*int64(ry)
+	x, y := int64(0), int64(ry)
+	// Region 1: slope above -1.
+	p := b2 - a2*int64(ry) + a2/4
+	for b2*x <= a2*y {
+		plot(int16(x), int16(y))
+		x++
+		if p < 0 {
+			p += b2 * (2*x + 1)
+		} else {
+			y--
+			p += b2*(2*x+1) - 2*a2*y
+		}
+	}
+	// Region 2: slope below -1.
+	p = b2*(2*x+1)*(2*x+1)/4 + a2*(y-1)*(y-1) - a2*b2
+	for y >= 0 {
+		plot(int16(x), int16(y))
+		y--
+		if p > 0 {
+			p += a2 * (1 - 2*y)
+		} else {
+			x++
+			p += b2*(2*x) + a2*(1-2*y)
+		}
+	}
+}
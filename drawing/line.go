@@ -0,0 +1,84 @@
+package drawing
+
+import (
+	"image/color"
+	"math"
+
+	"tinygo.org/x/drivers"
+)
+
+// Line draws a one pixel wide line between both end points (Bresenham).
+func Line(d drivers.Displayer, x0, y0, x1, y1 int16, c color.RGBA) {
+	if y0 == y1 {
+		HSpan(d, x0, x1, y0, c)
+		return
+	}
+	if x0 == x1 {
+		VSpan(d, x0, y0, y1, c)
+		return
+	}
+	dx := abs16(x1 - x0)
+	dy := -abs16(y1 - y0)
+	sx, sy := int16(1), int16(1)
+	if x0 > x1 {
+		sx = -1
+	}
+	if y0 > y1 {
+		sy = -1
+	}
+	err := int32(dx) + int32(dy)
+	for {
+		d.SetPixel(x0, y0, c)
+		if x0 == x1 && y0 == y1 {
+			return
+		}
+		e2 := 2 * err
+		if e2 >= int32(dy) {
+			err += int32(dy)
+			x0 += sx
+		}
+		if e2 <= int32(dx) {
+			err += int32(dx)
+			y0 += sy
+		}
+	}
+}
+
+// ThickLine draws a line width pixels wide with square ends. Axis-aligned
+// lines become rectangles; others are filled as a quadrilateral.
+func ThickLine(d drivers.Displayer, x0, y0, x1, y1, width int16, c color.RGBA) {
+	if width <= 1 {
+		Line(d, x0, y0, x1, y1, c)
+		return
+	}
+	half := width / 2
+	if y0 == y1 {
+		if x0 > x1 {
+			x0, x1 = x1, x0
+		}
+		FillRect(d, x0, y0-half, x1-x0+1, width, c)
+		return
+	}
+	if x0 == x1 {
+		if y0 > y1 {
+			y0, y1 = y1, y0
+		}
+		FillRect(d, x0-half, y0, width, y1-y0+1, c)
+		return
+	}
+	fx, fy := float64(x1-x0), float64(y1-y0)
+	length := math.Hypot(fx, fy)
+	// Perpendicular offset of half the width on each side.
+	ox := -fy / length * float64(width) / 2
+	oy := fx / length * float64(width) / 2
+	FillPolygon(d, []Point{
+		{X: x0 + round16(ox), Y: y0 + round16(oy)},
+		{X: x1 + round16(ox), Y: y1 + round16(oy)},
+		{X: x1 - round16(ox), Y: y1 - round16(oy)},
+		{X: x0 - round16(ox), Y: y0 - round16(oy)},
+	}, c)
+}
+
+func round16(v float64) int16 {
+	return int16(math.Round(v))
+}
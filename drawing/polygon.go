@@ -0,0 +1,70 @@
+package drawing
+
+import (
+	"image/color"
+
+	"tinygo.org/x/drivers"
+)
+
+// maxCrossings bounds the edges a single scanline may cross.
+const maxCrossings = 64
+
+// FillPolygon fills the polygon described by points using the even-odd rule.
+// The outline is closed automatically.
+func FillPolygon(d drivers.Displayer, points []Point, c color.RGBA) {
+	if len(points) < 3 {
+		return
+	}
+	minY, maxY := points[0].Y, points[0].Y
+	for _, p := range points[1:] {
+		if p.Y < minY {
+			minY = p.Y
+		}
+		if p.Y > maxY {
+			maxY = p.Y
+		}
+	}
+	var xs [maxCrossings]int16
+	for y := minY; y <= maxY; y++ {
+		n := 0
+		j := len(points) - 1
+		for i := range points {
+			a, b := points[i], points[j]
+			j = i
+			// Sample at the pixel centre so shared vertices count once.
+			if (a.Y <= y) == (b.Y <= y) || n == maxCrossings {
+				continue
+			}
+			num := int32(y-a.Y)*int32(b.X-a.X)*2 + int32(b.Y-a.Y)
+			xs[n] = a.X + int16(num/(int32(b.Y-a.Y)*2))
+			n++
+		}
+		sortInt16(xs[:n])
+		for k := 0; k+1 < n; k += 2 {
+			HSpan(d, xs[k], xs[k+1], y, c)
+		}
+	}
+	// Horizontal edges at the extremes contribute no crossings; draw the
+	// outline so they are not lost.
+	Polygon(d, points, c)
+}
+
+// Polygon draws the closed outline through points.
+func Polygon(d drivers.Displayer, points []Point, c color.RGBA) {
+	if len(points) == 0 {
+		return
+	}
+	prev := points[len(points)-1]
+	for _, p := range points {
+		Line(d, prev.X, prev.Y, p.X, p.Y, c)
+		prev = p
+	}
+}
+
+func sortInt16(v []int16) {
+	for i := 1; i < len(v); i++ {
+		for j := i; j > 0 && v[j] < v[j-1]; j-- {
+			v[j], v[j-1] = v[j-1], v[j]
+		}
+	}
+}
@@ -0,0 +1,110 @@
+package drawing
+
+import (
+	"image/color"
+	"math"
+
+	"tinygo.org/x/drivers"
+)
+
+// Rect draws a rectangle outline whose border is border pixels wide, drawn
+// inwards from the w×h bounds.
+func Rect(d drivers.Displayer, x, y, w, h, border int16, c color.RGBA) {
+	if w <= 0 || h <= 0 {
+		return
+	}
+	if border <= 0 {
+		border = 1
+	}
+	if 2*border >= w || 2*border >= h {
+		FillRect(d, x, y, w, h, c)
+		return
+	}
+	FillRect(d, x, y, w, border, c)
+	FillRect(d, x, y+h-border, w, border, c)
+	FillRect(d, x, y+border, border, h-2*border, c)
+	FillRect(d, x+w-border, y+border, border, h-2*border, c)
+}
+
+// RoundRect draws a rectangle outline with corners of the given radius and a
+// border drawn inwards from the bounds.
+func RoundRect(d drivers.Displayer, x, y, w, h, radius, border int16, c color.RGBA) {
+	if w <= 0 || h <= 0 {
+		return
+	}
+	radius = clampRadius(radius, w, h)
+	if radius == 0 {
+		Rect(d, x, y, w, h, border, c)
+		return
+	}
+	if border <= 0 {
+		border = 1
+	}
+	iw, ih := w-2*border, h-2*border
+	ir := radius - border
+	if ir < 0 {
+		ir = 0
+	}
+	for row := int16(0); row < h; row++ {
+		o0, o1 := roundSpan(w, radius, h, row)
+		inner := row-border >= 0 && row-border < ih && iw > 0
+		if !inner {
+			HSpan(d, x+o0, x+o1, y+row, c)
+			continue
+		}
+		i0, i1 := roundSpan(iw, clampRadius(ir, iw, ih), ih, row-border)
+		i0 += border
+		i1 += border
+		if i0 > o0 {
+			HSpan(d, x+o0, x+i0-1, y+row, c)
+		}
+		if i1 < o1 {
+			HSpan(d, x+i1+1, x+o1, y+row, c)
+		}
+	}
+}
+
+// FillRoundRect fills a rectangle with corners of the given radius.
+func FillRoundRect(d drivers.Displayer, x, y, w, h, radius int16, c color.RGBA) {
+	if w <= 0 || h <= 0 {
+		return
+	}
+	radius = clampRadius(radius, w, h)
+	FillRect(d, x, y+radius, w, h-2*radius, c)
+	for row := int16(0); row < radius; row++ {
+		x0, x1 := roundSpan(w, radius, h, row)
+		HSpan(d, x+x0, x+x1, y+row, c)
+		HSpan(d, x+x0, x+x1, y+h-1-row, c)
+	}
+}
+
+// roundSpan returns the first and last column covered on row of a w×h
+// rectangle with rounded corners.
+func roundSpan(w, radius, h, row int16) (int16, int16) {
+	dy := int16(-1)
+	if row < radius {
+		dy = radius - row
+	} else if row >= h-radius {
+		dy = row - (h - 1 - radius)
+	}
+	if dy < 0 {
+		return 0, w - 1
+	}
+	r := float64(radius)
+	fy := float64(dy) - 0.5
+	inset := radius - int16(math.Round(math.Sqrt(math.Max(0, r*r-fy*fy))))
+	return inset, w - 1 - inset
+}
+
+func clampRadius(radius, w, h int16) int16 {
+	if radius < 0 {
+		return 0
+	}
+	if 2*radius > w {
+		radius = w / 2
+	}
+	if 2*radius > h {
+		radius = h / 2
+	}
+	return radius
+}
@@ -0,0 +1,57 @@
+package drawing
+
+import (
+	"image/color"
+
+	ui "github.com/itohio/tinygui"
+	"tinygo.org/x/drivers"
+)
+
+// Point is a vertex in display coordinates.
+type Point struct {
+	X, Y int16
+}
+
+// HSpan draws the horizontal run x0..x1 (inclusive) on row y.
+func HSpan(d drivers.Displayer, x0, x1, y int16, c color.RGBA) {
+	if x0 > x1 {
+		x0, x1 = x1, x0
+	}
+	if fast, ok := d.(ui.LineDisplayer); ok {
+		fast.DrawFastHLine(x0, x1, y, c)
+		return
+	}
+	if fast, ok := d.(ui.RectangleDisplayer); ok {
+		_ = fast.FillRectangle(x0, y, x1-x0+1, 1, c)
+		return
+	}
+	ui.HLine(d, x0, y, x1-x0+1, c)
+}
+
+// VSpan draws the vertical run y0..y1 (inclusive) on column x.
+func VSpan(d drivers.Displayer, x, y0, y1 int16, c color.RGBA) {
+	if y0 > y1 {
+		y0, y1 = y1, y0
+	}
+	if fast, ok := d.(ui.LineDisplayer); ok {
+		fast.DrawFastVLine(x, y0, y1, c)
+		return
+	}
+	if fast, ok := d.(ui.RectangleDisplayer); ok {
+		_ = fast.FillRectangle(x, y0, 1, y1-y0+1, c)
+		return
+	}
+	ui.VLine(d, x, y0, y1-y0+1, c)
+}
+
+// FillRect fills a w×h rectangle.
+func FillRect(d drivers.Displayer, x, y, w, h int16, c color.RGBA) {
+	ui.FillRect(d, x, y, w, h, c)
+}
+
+func abs16(v int16) int16 {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
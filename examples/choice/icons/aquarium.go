@@ -1,3 +1,5 @@
+// Code generated by png2bin from aquarium.png; DO NOT EDIT.
+
 package icons
 
 const (
@@ -5,166 +7,140 @@ const (
 	AquariumHeight = 40
 )
 
-var (
-	AquariumPng = []uint16{
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 0, 8616, 27733,
-		29879, 29879, 29879, 29879, 29879, 29879, 29879, 29879, 31959, 31959,
-		29879, 29879, 29879, 29879, 29879, 29879, 29879, 29879, 27733, 8584,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 0, 27733, 57247,
-		57247, 57247, 57247, 57247, 57247, 57247, 57247, 57247, 57247, 57247,
-		57247, 57247, 57247, 57247, 57247, 57247, 57247, 57247, 57247, 27733,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 0, 27733, 57247,
-		57247, 57247, 57247, 57247, 57247, 57247, 57247, 57247, 57247, 57247,
-		57247, 57247, 57247, 57247, 57247, 57247, 57247, 57247, 57247, 27733,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 0, 10763, 31992,
-		38299, 40411, 40411, 40411, 40411, 40411, 40411, 40411, 40411, 40411,
-		40411, 40411, 40411, 40411, 40411, 40411, 40411, 38298, 29912, 10730,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 12811, 34072, 57215,
-		57247, 57247, 57247, 57247, 57247, 57247, 57247, 57247, 57247, 57247,
-		57247, 57247, 57247, 57247, 57247, 57247, 57247, 57247, 55167, 31959,
-		10730, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 17135, 44636, 57247, 57247,
-		57247, 57247, 57247, 57247, 57247, 57247, 57247, 57247, 57247, 57247,
-		57247, 57247, 57247, 57247, 57247, 57247, 57247, 57247, 57247, 57247,
-		42490, 15022, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 19216, 48829, 57247, 57247, 57247,
-		57247, 57247, 57247, 57247, 57247, 57247, 57247, 57247, 57247, 57247,
-		57247, 57247, 57247, 57247, 57247, 57247, 57247, 57247, 57247, 57247,
-		57247, 48797, 17135, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 17102, 48829, 57247, 57247, 57247, 57247,
-		57247, 57247, 57247, 57247, 57247, 57247, 57247, 57247, 57247, 57247,
-		57247, 57247, 57247, 57247, 57247, 57247, 57247, 57247, 57247, 57247,
-		57247, 57247, 46716, 14956, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 8617, 42491, 57247, 57247, 57247, 57247, 57247,
-		57247, 57247, 57247, 57247, 57247, 57247, 57247, 57247, 57247, 57247,
-		57247, 57247, 57247, 57247, 57247, 57247, 57247, 57247, 57247, 57247,
-		57247, 57247, 57247, 40378, 8584, 0, 0, 0, 0, 0,
-		0, 0, 65, 29846, 57247, 57247, 57247, 57247, 57247, 57247,
-		57247, 57247, 57247, 57247, 57247, 57247, 57247, 57247, 57247, 57247,
-		57247, 57247, 57247, 57247, 57247, 57247, 57247, 57247, 57247, 57247,
-		57247, 57247, 57247, 57247, 27733, 33, 0, 0, 0, 0,
-		0, 0, 21461, 29946, 29946, 29946, 29946, 29946, 29946, 29946,
-		29946, 29946, 29946, 29946, 29946, 29946, 29946, 29946, 29946, 29946,
-		29946, 29946, 29946, 38332, 42557, 42557, 42557, 38332, 29946, 29946,
-		29946, 29946, 29946, 29946, 29946, 21461, 0, 0, 0, 0,
-		0, 4357, 29914, 36286, 36286, 36286, 36286, 36286, 36286, 36286,
-		36286, 36286, 36286, 36286, 36286, 36286, 36286, 36286, 36286, 36286,
-		36286, 36286, 36286, 55167, 57247, 57247, 57247, 55167, 36286, 36286,
-		36286, 36286, 36286, 36286, 36286, 29914, 4325, 0, 0, 0,
-		0, 21461, 36285, 36286, 36286, 36286, 36286, 36286, 36286, 36286,
-		36286, 36286, 36286, 36286, 36286, 36286, 36286, 36286, 36286, 36286,
-		36286, 36286, 36286, 55167, 57247, 57247, 57247, 55135, 36286, 36286,
-		36286, 36286, 36286, 36286, 36286, 36285, 21428, 0, 0, 0,
-		32, 25753, 36286, 36286, 36286, 36286, 36286, 36286, 36286, 36286,
-		36286, 36286, 36286, 36286, 36286, 36286, 36286, 36286, 36286, 36286,
-		36286, 36286, 36286, 44702, 57247, 57247, 57247, 44670, 36286, 36286,
-		36286, 36286, 36286, 36286, 36286, 36286, 25753, 33, 0, 0,
-		10763, 32060, 36286, 36286, 36286, 36286, 36286, 36286, 36286, 36286,
-		36286, 36286, 36286, 36286, 36286, 36286, 36286, 36286, 36286, 36286,
-		36286, 36286, 36286, 36286, 40510, 44702, 40510, 36286, 36286, 36286,
-		36286, 36286, 36286, 36286, 36286, 36286, 32092, 8682, 0, 0,
-		21428, 36285, 36286, 36286, 36286, 36286, 36286, 36286, 36286, 36286,
-		36286, 36286, 36286, 36286, 36286, 36286, 36286, 36286, 36286, 36286,
-		36286, 36286, 36286, 36286, 36286, 36286, 36286, 36286, 36286, 36286,
-		36286, 36286, 36286, 36286, 36286, 36286, 36285, 21396, 0, 0,
-		23607, 36286, 36286, 36286, 36286, 36286, 36286, 36286, 36286, 36286,
-		36286, 36286, 36286, 36286, 36286, 36286, 36286, 36286, 36286, 36286,
-		36286, 36286, 36286, 36286, 36286, 36286, 36286, 36286, 36286, 36286,
-		36286, 36286, 36286, 36286, 36286, 36286, 36286, 23607, 0, 0,
-		25720, 36286, 36286, 36286, 36286, 36286, 36286, 36286, 36286, 36286,
-		36286, 36286, 36286, 36286, 36286, 42130, 48304, 46224, 40116, 36253,
-		36286, 36286, 36286, 36286, 36286, 36286, 36286, 36286, 36286, 46783,
-		57247, 46783, 36286, 36286, 36286, 36286, 36286, 25720, 0, 0,
-		27801, 36286, 36286, 36286, 36286, 36286, 36286, 36286, 36286, 36286,
-		36286, 36286, 36286, 36286, 36286, 42130, 65075, 65075, 62930, 48271,
-		38167, 36286, 36286, 36286, 36286, 36286, 36286, 36286, 36286, 57247,
-		57247, 55167, 36286, 36286, 36286, 36286, 36286, 27801, 0, 0,
-		27833, 36286, 36286, 36286, 36286, 36286, 36286, 36286, 36286, 36286,
-		36286, 36286, 36286, 36286, 36286, 42130, 65075, 65075, 65075, 65075,
-		54544, 46256, 42162, 36219, 36286, 36286, 36286, 36286, 36286, 46783,
-		57247, 46783, 36286, 36286, 36286, 36286, 36286, 27833, 0, 0,
-		27833, 36286, 36286, 36286, 36286, 36286, 36286, 36286, 36286, 36286,
-		36286, 36286, 36286, 36219, 42129, 52431, 65075, 65075, 65075, 65075,
-		65075, 65075, 65010, 52432, 42164, 36285, 36286, 36286, 36286, 36286,
-		36286, 36286, 36286, 36286, 36286, 36286, 36286, 27801, 0, 0,
-		27800, 36286, 36286, 36286, 36286, 36286, 36286, 36286, 36286, 42030,
-		48304, 44178, 40149, 48303, 65043, 65075, 65075, 65075, 65075, 65075,
-		65075, 65403, 65535, 65403, 60850, 42130, 36286, 36286, 36286, 36286,
-		36286, 36286, 36286, 36286, 36286, 36286, 36286, 25752, 0, 0,
-		25687, 36286, 36286, 36286, 36286, 36286, 36286, 36286, 36286, 40148,
-		62898, 65043, 60817, 65075, 65075, 65075, 65075, 65075, 65075, 65075,
-		65240, 50712, 14857, 50744, 65240, 58737, 40181, 36286, 36286, 36286,
-		36286, 36286, 36286, 36286, 36286, 36286, 36286, 25687, 0, 0,
-		23542, 36286, 36286, 36286, 36286, 36286, 36286, 36286, 36286, 36219,
-		52463, 65075, 65075, 65075, 65075, 65075, 65075, 65075, 65075, 65075,
-		65240, 50744, 14857, 50745, 65240, 65075, 46191, 36286, 36286, 36286,
-		36286, 36286, 36286, 36286, 36286, 36286, 36286, 21493, 0, 0,
-		17136, 34173, 36286, 36286, 36286, 36286, 36286, 36286, 36286, 40148,
-		62898, 65011, 58737, 65075, 65075, 65075, 65075, 65075, 65075, 65075,
-		65075, 65403, 65535, 65371, 65075, 58769, 42164, 36286, 36286, 36286,
-		36286, 36286, 36286, 36286, 36286, 36286, 34172, 17136, 0, 0,
-		2179, 29946, 36286, 36286, 36286, 36286, 36286, 36286, 36286, 41998,
-		46224, 42162, 40182, 48271, 62962, 65075, 65075, 65075, 65075, 65075,
-		65075, 65075, 65075, 65075, 60850, 42096, 36285, 36286, 36286, 36286,
-		36286, 36286, 36286, 36286, 36286, 36286, 27866, 2211, 0, 0,
-		0, 23639, 36286, 36286, 36286, 36286, 36286, 36286, 36286, 36286,
-		36286, 36286, 36286, 36252, 42163, 50351, 65075, 65075, 65075, 65075,
-		65075, 65075, 65043, 52464, 42162, 36285, 36286, 36286, 36286, 36286,
-		36286, 36286, 36286, 36286, 36286, 36286, 23607, 0, 0, 0,
-		0, 12909, 32092, 36286, 36286, 36286, 36286, 36286, 36286, 36286,
-		36286, 36286, 36286, 36286, 36286, 42130, 65075, 65010, 50351, 48303,
-		48304, 46256, 42130, 38234, 36286, 36286, 36286, 36286, 36286, 36286,
-		36286, 36286, 36286, 36286, 36286, 32092, 12942, 0, 0, 0,
-		0, 0, 25688, 36286, 36286, 36286, 36286, 36286, 36286, 36286,
-		36286, 36286, 36286, 36286, 36286, 42130, 54544, 44176, 36252, 36286,
-		36286, 36286, 36286, 36286, 36286, 36286, 36286, 36286, 36286, 36286,
-		36286, 36286, 36286, 36286, 36286, 25687, 0, 0, 0, 0,
-		0, 0, 10795, 32059, 36286, 36286, 36286, 36286, 36286, 36286,
-		36286, 36286, 36286, 36286, 36286, 38201, 38234, 36286, 36286, 36286,
-		36286, 36286, 36286, 36286, 36286, 36286, 36286, 36286, 36286, 36286,
-		36286, 36286, 36286, 36286, 29979, 10796, 0, 0, 0, 0,
-		0, 0, 0, 21461, 34205, 36286, 36286, 36286, 36286, 36286,
-		36286, 36286, 36286, 36286, 36286, 36286, 36286, 36286, 36286, 36286,
-		36286, 36286, 36286, 36286, 36286, 36286, 36286, 36286, 36286, 36286,
-		36286, 36286, 36286, 34205, 21461, 0, 0, 0, 0, 0,
-		0, 0, 0, 65, 25688, 36285, 36286, 36286, 36286, 36286,
-		36286, 36286, 36286, 36286, 36286, 36286, 36286, 36286, 36286, 36286,
-		36286, 36286, 36286, 36286, 36286, 36286, 36286, 36286, 36286, 36286,
-		36286, 36286, 36285, 23639, 65, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 4325, 25720, 36285, 36286, 36286, 36286,
-		36286, 36286, 36286, 36286, 36286, 36286, 36286, 36286, 36286, 36286,
-		36286, 36286, 36286, 36286, 36286, 36286, 36286, 36286, 36286, 36286,
-		36286, 36285, 25720, 4292, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 6438, 25720, 36285, 36286, 36286,
-		36286, 36286, 36286, 36286, 36286, 36286, 36286, 36286, 36286, 36286,
-		36286, 36286, 36286, 36286, 36286, 36286, 36286, 36286, 36286, 36286,
-		36285, 25720, 6438, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 2211, 23607, 34172, 36286,
-		36286, 36286, 36286, 36286, 36286, 36286, 36286, 36286, 36286, 36286,
-		36286, 36286, 36286, 36286, 36286, 36286, 36286, 36286, 36286, 34172,
-		23607, 2179, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 0, 17136, 25687,
-		27801, 27833, 27833, 27833, 27833, 27833, 27833, 27833, 27833, 27833,
-		27833, 27833, 27833, 27833, 27833, 27833, 27833, 27801, 25687, 15088,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 0, 0}
-)
+// AquariumPng holds the 40×40 image as RGB565 pixels.
+var AquariumPng = []uint16{
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x21a8, 0x6c55, 0x74b7, 0x74b7, 0x74b7, 0x74b7, 0x74b7,
+	0x74b7, 0x74b7, 0x74b7, 0x7cd7, 0x7cd7, 0x74b7, 0x74b7, 0x74b7, 0x74b7, 0x74b7, 0x74b7, 0x74b7,
+	0x74b7, 0x6c55, 0x2188, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x6c55, 0xdf9f, 0xdf9f,
+	0xdf9f, 0xdf9f, 0xdf9f, 0xdf9f, 0xdf9f, 0xdf9f, 0xdf9f, 0xdf9f, 0xdf9f, 0xdf9f, 0xdf9f, 0xdf9f,
+	0xdf9f, 0xdf9f, 0xdf9f, 0xdf9f, 0xdf9f, 0xdf9f, 0x6c55, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x6c55, 0xdf9f, 0xdf9f, 0xdf9f, 0xdf9f, 0xdf9f, 0xdf9f, 0xdf9f, 0xdf9f, 0xdf9f, 0xdf9f,
+	0xdf9f, 0xdf9f, 0xdf9f, 0xdf9f, 0xdf9f, 0xdf9f, 0xdf9f, 0xdf9f, 0xdf9f, 0xdf9f, 0x6c55, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x2a0b, 0x7cf8, 0x959b, 0x9ddb, 0x9ddb, 0x9ddb, 0x9ddb,
+	0x9ddb, 0x9ddb, 0x9ddb, 0x9ddb, 0x9ddb, 0x9ddb, 0x9ddb, 0x9ddb, 0x9ddb, 0x9ddb, 0x9ddb, 0x9ddb,
+	0x959a, 0x74d8, 0x29ea, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x320b, 0x8518, 0xdf7f, 0xdf9f,
+	0xdf9f, 0xdf9f, 0xdf9f, 0xdf9f, 0xdf9f, 0xdf9f, 0xdf9f, 0xdf9f, 0xdf9f, 0xdf9f, 0xdf9f, 0xdf9f,
+	0xdf9f, 0xdf9f, 0xdf9f, 0xdf9f, 0xdf9f, 0xd77f, 0x7cd7, 0x29ea, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x42ef,
+	0xae5c, 0xdf9f, 0xdf9f, 0xdf9f, 0xdf9f, 0xdf9f, 0xdf9f, 0xdf9f, 0xdf9f, 0xdf9f, 0xdf9f, 0xdf9f,
+	0xdf9f, 0xdf9f, 0xdf9f, 0xdf9f, 0xdf9f, 0xdf9f, 0xdf9f, 0xdf9f, 0xdf9f, 0xdf9f, 0xdf9f, 0xa5fa,
+	0x3aae, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x4b10, 0xbebd, 0xdf9f, 0xdf9f, 0xdf9f, 0xdf9f, 0xdf9f, 0xdf9f, 0xdf9f, 0xdf9f,
+	0xdf9f, 0xdf9f, 0xdf9f, 0xdf9f, 0xdf9f, 0xdf9f, 0xdf9f, 0xdf9f, 0xdf9f, 0xdf9f, 0xdf9f, 0xdf9f,
+	0xdf9f, 0xdf9f, 0xdf9f, 0xdf9f, 0xbe9d, 0x42ef, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x42ce, 0xbebd, 0xdf9f, 0xdf9f, 0xdf9f, 0xdf9f, 0xdf9f,
+	0xdf9f, 0xdf9f, 0xdf9f, 0xdf9f, 0xdf9f, 0xdf9f, 0xdf9f, 0xdf9f, 0xdf9f, 0xdf9f, 0xdf9f, 0xdf9f,
+	0xdf9f, 0xdf9f, 0xdf9f, 0xdf9f, 0xdf9f, 0xdf9f, 0xdf9f, 0xdf9f, 0xdf9f, 0xb67c, 0x3a6c, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x21a9, 0xa5fb, 0xdf9f, 0xdf9f,
+	0xdf9f, 0xdf9f, 0xdf9f, 0xdf9f, 0xdf9f, 0xdf9f, 0xdf9f, 0xdf9f, 0xdf9f, 0xdf9f, 0xdf9f, 0xdf9f,
+	0xdf9f, 0xdf9f, 0xdf9f, 0xdf9f, 0xdf9f, 0xdf9f, 0xdf9f, 0xdf9f, 0xdf9f, 0xdf9f, 0xdf9f, 0xdf9f,
+	0xdf9f, 0xdf9f, 0x9dba, 0x2188, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0041,
+	0x7496, 0xdf9f, 0xdf9f, 0xdf9f, 0xdf9f, 0xdf9f, 0xdf9f, 0xdf9f, 0xdf9f, 0xdf9f, 0xdf9f, 0xdf9f,
+	0xdf9f, 0xdf9f, 0xdf9f, 0xdf9f, 0xdf9f, 0xdf9f, 0xdf9f, 0xdf9f, 0xdf9f, 0xdf9f, 0xdf9f, 0xdf9f,
+	0xdf9f, 0xdf9f, 0xdf9f, 0xdf9f, 0xdf9f, 0xdf9f, 0xdf9f, 0x6c55, 0x0021, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x53d5, 0x74fa, 0x74fa, 0x74fa, 0x74fa, 0x74fa, 0x74fa, 0x74fa, 0x74fa,
+	0x74fa, 0x74fa, 0x74fa, 0x74fa, 0x74fa, 0x74fa, 0x74fa, 0x74fa, 0x74fa, 0x74fa, 0x74fa, 0x74fa,
+	0x95bc, 0xa63d, 0xa63d, 0xa63d, 0x95bc, 0x74fa, 0x74fa, 0x74fa, 0x74fa, 0x74fa, 0x74fa, 0x74fa,
+	0x53d5, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x1105, 0x74da, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe,
+	0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe,
+	0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0xd77f, 0xdf9f, 0xdf9f, 0xdf9f, 0xd77f, 0x8dbe, 0x8dbe, 0x8dbe,
+	0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x74da, 0x10e5, 0x0000, 0x0000, 0x0000, 0x0000, 0x53d5, 0x8dbd,
+	0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe,
+	0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0xd77f, 0xdf9f, 0xdf9f, 0xdf9f,
+	0xd75f, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbd, 0x53b4, 0x0000, 0x0000,
+	0x0000, 0x0020, 0x6499, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe,
+	0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe,
+	0xae9e, 0xdf9f, 0xdf9f, 0xdf9f, 0xae7e, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe,
+	0x8dbe, 0x6499, 0x0021, 0x0000, 0x0000, 0x2a0b, 0x7d3c, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe,
+	0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe,
+	0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x9e3e, 0xae9e, 0x9e3e, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe,
+	0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x7d5c, 0x21ea, 0x0000, 0x0000, 0x53b4, 0x8dbd, 0x8dbe,
+	0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe,
+	0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe,
+	0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbd, 0x5394, 0x0000,
+	0x0000, 0x5c37, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe,
+	0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe,
+	0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe,
+	0x8dbe, 0x8dbe, 0x5c37, 0x0000, 0x0000, 0x6478, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe,
+	0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0xa492, 0xbcb0, 0xb490, 0x9cb4,
+	0x8d9d, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0xb6bf, 0xdf9f,
+	0xb6bf, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x6478, 0x0000, 0x0000, 0x6c99, 0x8dbe, 0x8dbe,
+	0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe,
+	0xa492, 0xfe33, 0xfe33, 0xf5d2, 0xbc8f, 0x9517, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe,
+	0x8dbe, 0x8dbe, 0xdf9f, 0xdf9f, 0xd77f, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x6c99, 0x0000,
+	0x0000, 0x6cb9, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe,
+	0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0xa492, 0xfe33, 0xfe33, 0xfe33, 0xfe33, 0xd510, 0xb4b0, 0xa4b2,
+	0x8d7b, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0xb6bf, 0xdf9f, 0xb6bf, 0x8dbe, 0x8dbe, 0x8dbe,
+	0x8dbe, 0x8dbe, 0x6cb9, 0x0000, 0x0000, 0x6cb9, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe,
+	0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8d7b, 0xa491, 0xcccf, 0xfe33, 0xfe33, 0xfe33,
+	0xfe33, 0xfe33, 0xfe33, 0xfdf2, 0xccd0, 0xa4b4, 0x8dbd, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe,
+	0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x6c99, 0x0000, 0x0000, 0x6c98, 0x8dbe, 0x8dbe,
+	0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0xa42e, 0xbcb0, 0xac92, 0x9cd5, 0xbcaf, 0xfe13,
+	0xfe33, 0xfe33, 0xfe33, 0xfe33, 0xfe33, 0xfe33, 0xff7b, 0xffff, 0xff7b, 0xedb2, 0xa492, 0x8dbe,
+	0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x6498, 0x0000,
+	0x0000, 0x6457, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x9cd4, 0xf5b2,
+	0xfe13, 0xed91, 0xfe33, 0xfe33, 0xfe33, 0xfe33, 0xfe33, 0xfe33, 0xfe33, 0xfed8, 0xc618, 0x3a09,
+	0xc638, 0xfed8, 0xe571, 0x9cf5, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe,
+	0x8dbe, 0x8dbe, 0x6457, 0x0000, 0x0000, 0x5bf6, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe,
+	0x8dbe, 0x8dbe, 0x8d7b, 0xccef, 0xfe33, 0xfe33, 0xfe33, 0xfe33, 0xfe33, 0xfe33, 0xfe33, 0xfe33,
+	0xfe33, 0xfed8, 0xc638, 0x3a09, 0xc639, 0xfed8, 0xfe33, 0xb46f, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe,
+	0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x53f5, 0x0000, 0x0000, 0x42f0, 0x857d, 0x8dbe,
+	0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x9cd4, 0xf5b2, 0xfdf3, 0xe571, 0xfe33, 0xfe33,
+	0xfe33, 0xfe33, 0xfe33, 0xfe33, 0xfe33, 0xfe33, 0xff7b, 0xffff, 0xff5b, 0xfe33, 0xe591, 0xa4b4,
+	0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x857c, 0x42f0, 0x0000,
+	0x0000, 0x0883, 0x74fa, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0xa40e, 0xb490,
+	0xa4b2, 0x9cf6, 0xbc8f, 0xf5f2, 0xfe33, 0xfe33, 0xfe33, 0xfe33, 0xfe33, 0xfe33, 0xfe33, 0xfe33,
+	0xfe33, 0xedb2, 0xa470, 0x8dbd, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe,
+	0x8dbe, 0x6cda, 0x08a3, 0x0000, 0x0000, 0x0000, 0x5c57, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe,
+	0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8d9c, 0xa4b3, 0xc4af, 0xfe33, 0xfe33, 0xfe33,
+	0xfe33, 0xfe33, 0xfe33, 0xfe13, 0xccf0, 0xa4b2, 0x8dbd, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe,
+	0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x5c37, 0x0000, 0x0000, 0x0000, 0x0000, 0x326d, 0x7d5c,
+	0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe,
+	0xa492, 0xfe33, 0xfdf2, 0xc4af, 0xbcaf, 0xbcb0, 0xb4b0, 0xa492, 0x955a, 0x8dbe, 0x8dbe, 0x8dbe,
+	0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x7d5c, 0x328e, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x6458, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe,
+	0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0xa492, 0xd510, 0xac90, 0x8d9c, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe,
+	0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe,
+	0x6457, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x2a2b, 0x7d3b, 0x8dbe, 0x8dbe, 0x8dbe,
+	0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x9539, 0x955a, 0x8dbe, 0x8dbe,
+	0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe,
+	0x8dbe, 0x8dbe, 0x8dbe, 0x751b, 0x2a2c, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x53d5, 0x859d, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe,
+	0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe,
+	0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x859d, 0x53d5, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0041, 0x6458, 0x8dbd, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe,
+	0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe,
+	0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbd, 0x5c57, 0x0041,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x10e5, 0x6478, 0x8dbd,
+	0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe,
+	0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe,
+	0x8dbd, 0x6478, 0x10c4, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x1926, 0x6478, 0x8dbd, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe,
+	0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe,
+	0x8dbe, 0x8dbe, 0x8dbe, 0x8dbd, 0x6478, 0x1926, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x08a3, 0x5c37, 0x857c, 0x8dbe, 0x8dbe,
+	0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe,
+	0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x8dbe, 0x857c, 0x5c37, 0x0883, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x42f0, 0x6457, 0x6c99, 0x6cb9, 0x6cb9, 0x6cb9, 0x6cb9, 0x6cb9, 0x6cb9, 0x6cb9, 0x6cb9,
+	0x6cb9, 0x6cb9, 0x6cb9, 0x6cb9, 0x6cb9, 0x6cb9, 0x6cb9, 0x6cb9, 0x6c99, 0x6457, 0x3af0, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000,
+}
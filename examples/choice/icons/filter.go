@@ -1,3 +1,5 @@
+// Code generated by png2bin from filter.png; DO NOT EDIT.
+
 package icons
 
 const (
@@ -5,166 +7,140 @@ const (
 	FilterHeight = 40
 )
 
-var (
-	FilterPng = []uint16{
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 0, 32, 32,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 32, 0, 391, 717, 717,
-		391, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 391, 3483, 3678, 3385, 3385,
-		3678, 3483, 359, 0, 0, 0, 0, 0, 0, 0,
-		32, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 32, 456, 3678, 945, 65, 0, 0,
-		65, 945, 3678, 391, 32, 0, 0, 0, 0, 0,
-		195, 3711, 3711, 3711, 3678, 3254, 32, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 32, 3548, 880, 0, 0, 0, 0,
-		0, 0, 880, 3548, 32, 0, 0, 0, 0, 0,
-		98, 391, 391, 391, 684, 3678, 684, 32, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 587, 3581, 0, 0, 0, 0, 0,
-		0, 0, 32, 3581, 554, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 3352, 815, 32, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 32, 913, 3189, 0, 0, 0, 0, 0,
-		0, 0, 0, 3221, 913, 32, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 3287, 815, 32, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 130, 130, 0, 0, 0, 0, 0,
-		0, 0, 33, 1043, 1043, 33, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 3287, 815, 32, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 33, 1043, 1043, 33, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 3287, 815, 32, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 65,
-		6339, 0, 33, 1043, 1043, 33, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 3287, 815, 32, 0, 0,
-		0, 65, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 8452,
-		4226, 0, 33, 1043, 1043, 33, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 652, 424, 0, 0, 0,
-		4226, 32, 4226, 0, 0, 0, 0, 0, 0, 0,
-		32, 25388, 25388, 25388, 25388, 25388, 10565, 0, 0, 8484,
-		2113, 0, 0, 554, 554, 0, 0, 0, 0, 0,
-		0, 0, 0, 6371, 8452, 6436, 6436, 6404, 0, 6371,
-		12678, 0, 4291, 0, 0, 0, 0, 0, 0, 0,
-		0, 52890, 55003, 55003, 55003, 55003, 16936, 0, 0, 8484,
-		32, 8452, 8452, 6436, 6436, 8452, 8452, 0, 0, 0,
-		0, 0, 6371, 38099, 38099, 38099, 38099, 38067, 8484, 0,
-		0, 0, 6371, 0, 0, 0, 0, 0, 0, 0,
-		0, 44406, 55003, 55003, 55003, 55003, 6372, 0, 0, 8484,
-		4226, 35954, 38099, 38099, 38099, 38099, 38099, 4226, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		4291, 0, 4291, 0, 0, 0, 0, 0, 0, 0,
-		32, 0, 0, 0, 0, 0, 0, 0, 0, 8484,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 848, 554, 32, 0, 0,
-		2113, 8484, 2113, 0, 0, 0, 0, 0, 0, 0,
-		97, 587, 3255, 3255, 3255, 1043, 0, 0, 0, 8484,
-		2113, 0, 32, 717, 717, 32, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 652, 424, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 586, 3711, 3711, 3711, 3254, 0, 0, 0, 8452,
-		4258, 0, 32, 294, 294, 32, 0, 0, 0, 0,
-		0, 0, 0, 0, 8484, 6436, 6436, 6339, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 293, 3711, 3711, 3711, 945, 65, 0, 0, 0,
-		32, 0, 0, 195, 195, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 38099, 38099, 38099, 27534, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 33, 3711, 3711, 3711, 685, 32, 0, 0, 0,
-		0, 0, 0, 391, 391, 0, 0, 0, 0, 0,
-		0, 32, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 391, 391, 0, 0, 0, 0, 0,
-		0, 6404, 38099, 40180, 40180, 40180, 40180, 40180, 40180, 40180,
-		40180, 40180, 40180, 40180, 40180, 40180, 40180, 40180, 40180, 40180,
-		40180, 38066, 35986, 35986, 35986, 35986, 38131, 40180, 40180, 33905,
-		32, 0, 0, 391, 391, 0, 0, 0, 0, 0,
-		32, 25421, 55003, 55003, 55003, 55003, 55003, 55003, 55003, 55003,
-		55003, 55003, 55003, 55003, 55003, 55003, 55003, 55003, 55003, 55003,
-		55003, 55003, 55003, 55003, 55003, 55003, 55003, 55003, 55003, 55003,
-		10597, 0, 0, 358, 391, 0, 0, 0, 0, 0,
-		32, 2113, 4258, 4258, 4258, 4258, 4258, 4258, 4258, 4258,
-		4258, 4258, 4258, 4258, 4258, 4258, 4258, 19082, 42358, 42358,
-		19082, 4258, 4258, 4258, 4258, 4258, 4258, 4258, 4258, 4259,
-		32, 65, 163, 0, 326, 0, 0, 0, 0, 0,
-		32, 8517, 19082, 19082, 19082, 19082, 19082, 19082, 19082, 19082,
-		4226, 8517, 19082, 19082, 19082, 19082, 19082, 27502, 33841, 33841,
-		6371, 8517, 19082, 19082, 19082, 19082, 19082, 19082, 19082, 19082,
-		4226, 163, 391, 228, 0, 0, 0, 0, 0, 0,
-		0, 16969, 38099, 38099, 38099, 38099, 38099, 38099, 38099, 38099,
-		6339, 16969, 38099, 38099, 38099, 38099, 38099, 38099, 38099, 38099,
-		6339, 16969, 38099, 38099, 38099, 38099, 38099, 38099, 38099, 38099,
-		6371, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 8452, 8484, 8484, 8484, 8484, 8484, 8484, 6371,
-		0, 0, 8452, 8484, 8484, 8484, 8484, 8484, 8484, 6371,
-		0, 0, 8452, 8484, 8484, 8484, 8484, 8484, 8484, 6371,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 32, 358, 586, 586, 586, 586, 196, 0,
-		0, 0, 32, 358, 586, 586, 586, 586, 196, 0,
-		0, 0, 32, 358, 586, 586, 586, 586, 196, 0,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 65, 847, 3385, 3385, 3385, 3385, 489, 0,
-		0, 0, 65, 847, 3385, 3385, 3385, 3385, 489, 0,
-		0, 0, 65, 847, 3385, 3385, 3385, 3385, 489, 0,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 33, 1043, 3711, 3711, 3711, 3711, 619, 0,
-		0, 0, 33, 1043, 3711, 3711, 3711, 3711, 619, 0,
-		0, 0, 33, 1043, 3711, 3711, 3711, 3711, 619, 0,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 33, 1043, 3711, 3711, 3711, 3711, 619, 0,
-		0, 0, 33, 1043, 3711, 3711, 3711, 3711, 619, 0,
-		0, 0, 33, 1043, 3711, 3711, 3711, 3711, 619, 0,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 33, 1043, 3711, 3711, 3711, 3711, 619, 0,
-		0, 0, 33, 1043, 3711, 3711, 3711, 3711, 619, 0,
-		0, 0, 33, 1043, 3711, 3711, 3711, 3711, 619, 0,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 33, 1043, 3711, 3711, 3711, 3711, 619, 0,
-		0, 0, 33, 1043, 3711, 3711, 3711, 3711, 619, 0,
-		0, 0, 33, 1043, 3711, 3711, 3711, 3711, 619, 0,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 33, 1043, 3711, 3711, 3711, 3711, 619, 0,
-		0, 0, 33, 1043, 3711, 3711, 3711, 3711, 619, 0,
-		0, 0, 33, 1043, 3711, 3711, 3711, 3711, 619, 0,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 33, 1043, 3711, 3711, 3711, 3711, 619, 0,
-		0, 0, 33, 1043, 3711, 3711, 3711, 3711, 619, 0,
-		0, 0, 33, 1043, 3711, 3711, 3711, 3711, 619, 0,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 32, 880, 3711, 3711, 3711, 3711, 391, 0,
-		0, 0, 32, 880, 3711, 3711, 3711, 3711, 391, 0,
-		0, 0, 32, 880, 3711, 3711, 3711, 3711, 391, 0,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 163, 3581, 3711, 3711, 3352, 0, 0,
-		0, 0, 0, 163, 3581, 3711, 3711, 3352, 0, 0,
-		0, 0, 0, 163, 3581, 3711, 3711, 3352, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 32, 98, 684, 587, 0, 0, 0,
-		0, 0, 0, 32, 98, 684, 587, 0, 0, 0,
-		0, 0, 0, 32, 98, 684, 587, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 0, 0}
-)
+// FilterPng holds the 40×40 image as RGB565 pixels.
+var FilterPng = []uint16{
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0020, 0x0020, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0020, 0x0000,
+	0x0187, 0x02cd, 0x02cd, 0x0187, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0187, 0x0d9b, 0x0e5e, 0x0d39, 0x0d39, 0x0e5e, 0x0d9b, 0x0167, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0020, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0020, 0x01c8, 0x0e5e, 0x03b1, 0x0041, 0x0000, 0x0000, 0x0041,
+	0x03b1, 0x0e5e, 0x0187, 0x0020, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x00c3, 0x0e7f, 0x0e7f,
+	0x0e7f, 0x0e5e, 0x0cb6, 0x0020, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0020, 0x0ddc, 0x0370, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0370, 0x0ddc, 0x0020, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0062, 0x0187, 0x0187, 0x0187, 0x02ac, 0x0e5e, 0x02ac, 0x0020, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x024b, 0x0dfd, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0020, 0x0dfd, 0x022a,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0d18, 0x032f,
+	0x0020, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0020, 0x0391, 0x0c75, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0c95, 0x0391, 0x0020, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0cd7, 0x032f, 0x0020, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0082, 0x0082, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0021, 0x0413, 0x0413, 0x0021, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0cd7, 0x032f, 0x0020, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0021, 0x0413, 0x0413,
+	0x0021, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0cd7, 0x032f,
+	0x0020, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0041, 0x18c3,
+	0x0000, 0x0021, 0x0413, 0x0413, 0x0021, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0cd7, 0x032f, 0x0020, 0x0000, 0x0000, 0x0000, 0x0041, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x2104, 0x1082, 0x0000, 0x0021, 0x0413, 0x0413, 0x0021, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x028c, 0x01a8, 0x0000, 0x0000, 0x0000, 0x1082,
+	0x0020, 0x1082, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0020, 0x632c, 0x632c,
+	0x632c, 0x632c, 0x632c, 0x2945, 0x0000, 0x0000, 0x2124, 0x0841, 0x0000, 0x0000, 0x022a, 0x022a,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x18e3, 0x2104, 0x1924, 0x1924,
+	0x1904, 0x0000, 0x18e3, 0x3186, 0x0000, 0x10c3, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0xce9a, 0xd6db, 0xd6db, 0xd6db, 0xd6db, 0x4228, 0x0000, 0x0000, 0x2124, 0x0020,
+	0x2104, 0x2104, 0x1924, 0x1924, 0x2104, 0x2104, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x18e3,
+	0x94d3, 0x94d3, 0x94d3, 0x94d3, 0x94b3, 0x2124, 0x0000, 0x0000, 0x0000, 0x18e3, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0xad76, 0xd6db, 0xd6db, 0xd6db, 0xd6db, 0x18e4,
+	0x0000, 0x0000, 0x2124, 0x1082, 0x8c72, 0x94d3, 0x94d3, 0x94d3, 0x94d3, 0x94d3, 0x1082, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x10c3,
+	0x0000, 0x10c3, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0020, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x2124, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0350, 0x022a,
+	0x0020, 0x0000, 0x0000, 0x0841, 0x2124, 0x0841, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0061, 0x024b, 0x0cb7, 0x0cb7, 0x0cb7, 0x0413, 0x0000, 0x0000, 0x0000, 0x2124, 0x0841,
+	0x0000, 0x0020, 0x02cd, 0x02cd, 0x0020, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x028c, 0x01a8, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x024a, 0x0e7f, 0x0e7f, 0x0e7f, 0x0cb6, 0x0000,
+	0x0000, 0x0000, 0x2104, 0x10a2, 0x0000, 0x0020, 0x0126, 0x0126, 0x0020, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x2124, 0x1924, 0x1924, 0x18c3, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0125, 0x0e7f,
+	0x0e7f, 0x0e7f, 0x03b1, 0x0041, 0x0000, 0x0000, 0x0000, 0x0020, 0x0000, 0x0000, 0x00c3, 0x00c3,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x94d3, 0x94d3, 0x94d3,
+	0x6b8e, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0021, 0x0e7f, 0x0e7f, 0x0e7f, 0x02ad, 0x0020, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0187, 0x0187, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0020, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0187, 0x0187, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x1904, 0x94d3, 0x9cf4, 0x9cf4, 0x9cf4, 0x9cf4, 0x9cf4, 0x9cf4, 0x9cf4, 0x9cf4,
+	0x9cf4, 0x9cf4, 0x9cf4, 0x9cf4, 0x9cf4, 0x9cf4, 0x9cf4, 0x9cf4, 0x9cf4, 0x9cf4, 0x94b2, 0x8c92,
+	0x8c92, 0x8c92, 0x8c92, 0x94f3, 0x9cf4, 0x9cf4, 0x8471, 0x0020, 0x0000, 0x0000, 0x0187, 0x0187,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0020, 0x634d, 0xd6db, 0xd6db, 0xd6db, 0xd6db, 0xd6db,
+	0xd6db, 0xd6db, 0xd6db, 0xd6db, 0xd6db, 0xd6db, 0xd6db, 0xd6db, 0xd6db, 0xd6db, 0xd6db, 0xd6db,
+	0xd6db, 0xd6db, 0xd6db, 0xd6db, 0xd6db, 0xd6db, 0xd6db, 0xd6db, 0xd6db, 0xd6db, 0xd6db, 0x2965,
+	0x0000, 0x0000, 0x0166, 0x0187, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0020, 0x0841, 0x10a2,
+	0x10a2, 0x10a2, 0x10a2, 0x10a2, 0x10a2, 0x10a2, 0x10a2, 0x10a2, 0x10a2, 0x10a2, 0x10a2, 0x10a2,
+	0x10a2, 0x10a2, 0x4a8a, 0xa576, 0xa576, 0x4a8a, 0x10a2, 0x10a2, 0x10a2, 0x10a2, 0x10a2, 0x10a2,
+	0x10a2, 0x10a2, 0x10a3, 0x0020, 0x0041, 0x00a3, 0x0000, 0x0146, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0020, 0x2145, 0x4a8a, 0x4a8a, 0x4a8a, 0x4a8a, 0x4a8a, 0x4a8a, 0x4a8a, 0x4a8a, 0x1082,
+	0x2145, 0x4a8a, 0x4a8a, 0x4a8a, 0x4a8a, 0x4a8a, 0x6b6e, 0x8431, 0x8431, 0x18e3, 0x2145, 0x4a8a,
+	0x4a8a, 0x4a8a, 0x4a8a, 0x4a8a, 0x4a8a, 0x4a8a, 0x4a8a, 0x1082, 0x00a3, 0x0187, 0x00e4, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x4249, 0x94d3, 0x94d3, 0x94d3, 0x94d3, 0x94d3,
+	0x94d3, 0x94d3, 0x94d3, 0x18c3, 0x4249, 0x94d3, 0x94d3, 0x94d3, 0x94d3, 0x94d3, 0x94d3, 0x94d3,
+	0x94d3, 0x18c3, 0x4249, 0x94d3, 0x94d3, 0x94d3, 0x94d3, 0x94d3, 0x94d3, 0x94d3, 0x94d3, 0x18e3,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x2104,
+	0x2124, 0x2124, 0x2124, 0x2124, 0x2124, 0x2124, 0x18e3, 0x0000, 0x0000, 0x2104, 0x2124, 0x2124,
+	0x2124, 0x2124, 0x2124, 0x2124, 0x18e3, 0x0000, 0x0000, 0x2104, 0x2124, 0x2124, 0x2124, 0x2124,
+	0x2124, 0x2124, 0x18e3, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0020, 0x0166, 0x024a, 0x024a, 0x024a, 0x024a, 0x00c4, 0x0000, 0x0000,
+	0x0000, 0x0020, 0x0166, 0x024a, 0x024a, 0x024a, 0x024a, 0x00c4, 0x0000, 0x0000, 0x0000, 0x0020,
+	0x0166, 0x024a, 0x024a, 0x024a, 0x024a, 0x00c4, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0041, 0x034f, 0x0d39, 0x0d39, 0x0d39,
+	0x0d39, 0x01e9, 0x0000, 0x0000, 0x0000, 0x0041, 0x034f, 0x0d39, 0x0d39, 0x0d39, 0x0d39, 0x01e9,
+	0x0000, 0x0000, 0x0000, 0x0041, 0x034f, 0x0d39, 0x0d39, 0x0d39, 0x0d39, 0x01e9, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0021,
+	0x0413, 0x0e7f, 0x0e7f, 0x0e7f, 0x0e7f, 0x026b, 0x0000, 0x0000, 0x0000, 0x0021, 0x0413, 0x0e7f,
+	0x0e7f, 0x0e7f, 0x0e7f, 0x026b, 0x0000, 0x0000, 0x0000, 0x0021, 0x0413, 0x0e7f, 0x0e7f, 0x0e7f,
+	0x0e7f, 0x026b, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0021, 0x0413, 0x0e7f, 0x0e7f, 0x0e7f, 0x0e7f, 0x026b, 0x0000, 0x0000,
+	0x0000, 0x0021, 0x0413, 0x0e7f, 0x0e7f, 0x0e7f, 0x0e7f, 0x026b, 0x0000, 0x0000, 0x0000, 0x0021,
+	0x0413, 0x0e7f, 0x0e7f, 0x0e7f, 0x0e7f, 0x026b, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0021, 0x0413, 0x0e7f, 0x0e7f, 0x0e7f,
+	0x0e7f, 0x026b, 0x0000, 0x0000, 0x0000, 0x0021, 0x0413, 0x0e7f, 0x0e7f, 0x0e7f, 0x0e7f, 0x026b,
+	0x0000, 0x0000, 0x0000, 0x0021, 0x0413, 0x0e7f, 0x0e7f, 0x0e7f, 0x0e7f, 0x026b, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0021,
+	0x0413, 0x0e7f, 0x0e7f, 0x0e7f, 0x0e7f, 0x026b, 0x0000, 0x0000, 0x0000, 0x0021, 0x0413, 0x0e7f,
+	0x0e7f, 0x0e7f, 0x0e7f, 0x026b, 0x0000, 0x0000, 0x0000, 0x0021, 0x0413, 0x0e7f, 0x0e7f, 0x0e7f,
+	0x0e7f, 0x026b, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0021, 0x0413, 0x0e7f, 0x0e7f, 0x0e7f, 0x0e7f, 0x026b, 0x0000, 0x0000,
+	0x0000, 0x0021, 0x0413, 0x0e7f, 0x0e7f, 0x0e7f, 0x0e7f, 0x026b, 0x0000, 0x0000, 0x0000, 0x0021,
+	0x0413, 0x0e7f, 0x0e7f, 0x0e7f, 0x0e7f, 0x026b, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0021, 0x0413, 0x0e7f, 0x0e7f, 0x0e7f,
+	0x0e7f, 0x026b, 0x0000, 0x0000, 0x0000, 0x0021, 0x0413, 0x0e7f, 0x0e7f, 0x0e7f, 0x0e7f, 0x026b,
+	0x0000, 0x0000, 0x0000, 0x0021, 0x0413, 0x0e7f, 0x0e7f, 0x0e7f, 0x0e7f, 0x026b, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0020,
+	0x0370, 0x0e7f, 0x0e7f, 0x0e7f, 0x0e7f, 0x0187, 0x0000, 0x0000, 0x0000, 0x0020, 0x0370, 0x0e7f,
+	0x0e7f, 0x0e7f, 0x0e7f, 0x0187, 0x0000, 0x0000, 0x0000, 0x0020, 0x0370, 0x0e7f, 0x0e7f, 0x0e7f,
+	0x0e7f, 0x0187, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x00a3, 0x0dfd, 0x0e7f, 0x0e7f, 0x0d18, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x00a3, 0x0dfd, 0x0e7f, 0x0e7f, 0x0d18, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x00a3, 0x0dfd, 0x0e7f, 0x0e7f, 0x0d18, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0020, 0x0062, 0x02ac, 0x024b,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0020, 0x0062, 0x02ac, 0x024b, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0020, 0x0062, 0x02ac, 0x024b, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000,
+}
@@ -1,3 +1,5 @@
+// Code generated by png2bin from food.png; DO NOT EDIT.
+
 package icons
 
 const (
@@ -5,166 +7,140 @@ const (
 	FoodHeight = 40
 )
 
-var (
-	FoodPng = []uint16{
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 2080, 2112, 2112, 2112, 32, 0, 0, 0, 2112,
-		2112, 2112, 2080, 0, 0, 0, 2080, 2112, 2112, 2112,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 0, 14625, 23010,
-		20897, 0, 20930, 25090, 14657, 2112, 23010, 23010, 8352, 8384,
-		25090, 25090, 2112, 14625, 23010, 20897, 0, 20930, 25090, 14657,
-		2112, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 0, 31362, 50180,
-		43908, 0, 46020, 54373, 33475, 6272, 50180, 50180, 18817, 18849,
-		54373, 54373, 6272, 31362, 50180, 43908, 0, 46020, 54373, 33475,
-		2112, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 0, 31362, 50180,
-		43908, 0, 46020, 54373, 33475, 6272, 50180, 50180, 18817, 18849,
-		54373, 54373, 6272, 31362, 50180, 43908, 0, 46020, 54373, 33475,
-		2112, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		2080, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 0, 8417, 43974,
-		43974, 43974, 43974, 43974, 43974, 43974, 43974, 43974, 43974, 43974,
-		43974, 43974, 43974, 43974, 43974, 43974, 43974, 43974, 43974, 8417,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 0, 27235, 64936,
-		64936, 64936, 64936, 64936, 64936, 64936, 64936, 64936, 64936, 64936,
-		64936, 64936, 64936, 64936, 64936, 64936, 64936, 64936, 64936, 25155,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 2080, 35620, 64936,
-		64936, 64936, 64936, 64936, 64936, 64936, 64936, 64936, 64936, 64936,
-		64936, 64936, 64936, 64936, 64936, 64936, 64936, 64936, 64936, 35620,
-		2080, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 2080, 39813, 64936,
-		64936, 64936, 64936, 64936, 64936, 64936, 64936, 64936, 64936, 64936,
-		64936, 64936, 64936, 64936, 64936, 64936, 64936, 64936, 64936, 39780,
-		2080, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 32, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		32, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 65, 653, 3093,
-		3093, 3093, 3093, 3093, 3093, 3093, 3093, 3093, 3093, 3093,
-		3093, 3093, 3093, 3093, 3093, 3093, 3093, 3093, 3093, 653,
-		65, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 65, 882, 3453,
-		3453, 3453, 9367, 9301, 3453, 3453, 3453, 3453, 3453, 3453,
-		3453, 3453, 3453, 3453, 3453, 3453, 3453, 3453, 3453, 882,
-		65, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 65, 882, 3453,
-		3453, 3453, 6535, 2210, 3453, 3453, 3453, 3453, 3453, 3289,
-		3289, 3453, 3453, 3453, 3453, 3453, 3453, 3453, 3453, 882,
-		65, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 65, 882, 3453,
-		3453, 3453, 7384, 7384, 3453, 3453, 3420, 653, 98, 0,
-		2439, 3453, 3453, 3453, 3453, 3453, 3453, 3453, 3453, 882,
-		65, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 65, 882, 3453,
-		9007, 4356, 5468, 3453, 3453, 3453, 457, 2145, 31170, 4160,
-		751, 3453, 3453, 3453, 3453, 3453, 3453, 3453, 3453, 882,
-		65, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 65, 882, 3453,
-		9105, 6632, 3452, 3453, 3453, 947, 0, 8320, 10400, 0,
-		718, 3322, 3453, 3453, 3060, 424, 2407, 784, 3453, 882,
-		65, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 65, 882, 3453,
-		3453, 3453, 3453, 3191, 326, 0, 2080, 12480, 29186, 22945,
-		6305, 0, 2636, 817, 0, 18721, 24929, 0, 3126, 882,
-		65, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 65, 882, 3453,
-		3453, 3453, 915, 32, 18818, 54212, 27074, 12480, 62564, 14561,
-		52099, 45859, 8353, 0, 22849, 56099, 51939, 0, 3126, 882,
-		65, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 65, 882, 3453,
-		3453, 2996, 0, 33378, 41635, 27073, 39586, 4160, 62564, 8320,
-		33282, 62564, 60484, 25025, 0, 33250, 33250, 2146, 3452, 882,
-		65, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 65, 882, 3453,
-		3453, 2996, 0, 33378, 41635, 27073, 39586, 4160, 62564, 8320,
-		33282, 62564, 60484, 25025, 0, 33250, 33250, 2146, 3452, 882,
-		65, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 65, 882, 3453,
-		3453, 3453, 915, 32, 18786, 54211, 27074, 12480, 62564, 14561,
-		52099, 43811, 8353, 0, 22849, 56099, 51939, 0, 3126, 882,
-		65, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 65, 882, 3453,
-		3453, 3453, 3453, 3191, 326, 0, 2080, 12480, 29186, 22945,
-		6305, 0, 2668, 817, 0, 20801, 27009, 0, 3126, 882,
-		65, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 65, 882, 3453,
-		3453, 3453, 3453, 3453, 3453, 947, 0, 8320, 10400, 0,
-		718, 3322, 3453, 3453, 3060, 424, 2407, 784, 3453, 882,
-		65, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 65, 882, 3453,
-		3453, 3453, 3453, 3453, 3453, 3453, 457, 2113, 31170, 4160,
-		751, 3453, 3453, 3354, 648, 3420, 3453, 3453, 3453, 882,
-		65, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 65, 882, 3453,
-		3453, 3453, 3453, 3453, 3453, 3453, 3420, 686, 98, 0,
-		2439, 3453, 3453, 3353, 64, 714, 3453, 3453, 3453, 882,
-		65, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 65, 882, 3453,
-		3453, 3453, 3453, 3453, 3453, 3453, 3453, 3453, 3453, 3289,
-		3322, 3453, 583, 1108, 648, 226, 3453, 583, 1108, 882,
-		65, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 65, 882, 3453,
-		3453, 3453, 3453, 3453, 3453, 3453, 3453, 3453, 3453, 3453,
-		3453, 3321, 0, 1009, 550, 290, 3321, 0, 1009, 882,
-		65, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 65, 882, 3453,
-		3453, 3453, 3453, 3453, 3453, 3453, 3453, 3453, 3453, 3453,
-		3453, 779, 161, 3452, 96, 845, 779, 161, 3452, 882,
-		65, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 65, 882, 3453,
-		3453, 3453, 3453, 3453, 3453, 3453, 3453, 3453, 3453, 3453,
-		3453, 747, 193, 3453, 64, 910, 747, 193, 3453, 882,
-		65, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 65, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		65, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 97, 557, 885,
-		885, 885, 885, 885, 885, 885, 885, 885, 885, 885,
-		885, 885, 885, 885, 885, 885, 885, 885, 885, 557,
-		97, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 97, 393, 655,
-		655, 655, 655, 655, 655, 655, 655, 655, 655, 655,
-		655, 655, 655, 655, 655, 655, 655, 655, 655, 393,
-		97, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 32, 8418, 12643,
-		12643, 12643, 12643, 12643, 12643, 12643, 12643, 12643, 12643, 12643,
-		12643, 12643, 12643, 12643, 12643, 12643, 12643, 12643, 12643, 8418,
-		32, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 2080, 39813, 64936,
-		64936, 64936, 64936, 64936, 64936, 64936, 64936, 64936, 64936, 64936,
-		64936, 64936, 64936, 64936, 64936, 64936, 64936, 64936, 64936, 39813,
-		2080, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 2080, 39813, 64936,
-		64936, 64936, 64936, 64936, 64936, 64936, 64936, 64936, 64936, 64936,
-		64936, 64936, 64936, 64936, 64936, 64936, 64936, 64936, 64936, 39813,
-		2080, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 2080, 37700, 64936,
-		64936, 64936, 64936, 64936, 64936, 64936, 64936, 64936, 64936, 64936,
-		64936, 64936, 64936, 64936, 64936, 64936, 64936, 64936, 64936, 37700,
-		2080, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 0, 4224, 31428,
-		33540, 33540, 33540, 33540, 33540, 33540, 33540, 33540, 33540, 33540,
-		33540, 33540, 33540, 33540, 33540, 33540, 33540, 33540, 31428, 4224,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 2080,
-		2080, 2080, 2080, 2080, 2080, 2080, 2080, 2080, 2080, 2080,
-		2080, 2080, 2080, 2080, 2080, 2080, 2080, 2080, 2080, 0,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 0, 0}
-)
+// FoodPng holds the 40×40 image as RGB565 pixels.
+var FoodPng = []uint16{
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0820, 0x0840, 0x0840, 0x0840, 0x0020, 0x0000, 0x0000, 0x0000,
+	0x0840, 0x0840, 0x0840, 0x0820, 0x0000, 0x0000, 0x0000, 0x0820, 0x0840, 0x0840, 0x0840, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x3921, 0x59e2, 0x51a1, 0x0000, 0x51c2, 0x6202, 0x3941,
+	0x0840, 0x59e2, 0x59e2, 0x20a0, 0x20c0, 0x6202, 0x6202, 0x0840, 0x3921, 0x59e2, 0x51a1, 0x0000,
+	0x51c2, 0x6202, 0x3941, 0x0840, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x7a82, 0xc404, 0xab84,
+	0x0000, 0xb3c4, 0xd465, 0x82c3, 0x1880, 0xc404, 0xc404, 0x4981, 0x49a1, 0xd465, 0xd465, 0x1880,
+	0x7a82, 0xc404, 0xab84, 0x0000, 0xb3c4, 0xd465, 0x82c3, 0x0840, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x7a82, 0xc404, 0xab84, 0x0000, 0xb3c4, 0xd465, 0x82c3, 0x1880, 0xc404, 0xc404, 0x4981,
+	0x49a1, 0xd465, 0xd465, 0x1880, 0x7a82, 0xc404, 0xab84, 0x0000, 0xb3c4, 0xd465, 0x82c3, 0x0840,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0820, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x20e1, 0xabc6, 0xabc6,
+	0xabc6, 0xabc6, 0xabc6, 0xabc6, 0xabc6, 0xabc6, 0xabc6, 0xabc6, 0xabc6, 0xabc6, 0xabc6, 0xabc6,
+	0xabc6, 0xabc6, 0xabc6, 0xabc6, 0xabc6, 0xabc6, 0x20e1, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x6a63, 0xfda8, 0xfda8, 0xfda8, 0xfda8, 0xfda8, 0xfda8, 0xfda8, 0xfda8, 0xfda8, 0xfda8,
+	0xfda8, 0xfda8, 0xfda8, 0xfda8, 0xfda8, 0xfda8, 0xfda8, 0xfda8, 0xfda8, 0xfda8, 0x6243, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0820, 0x8b24, 0xfda8, 0xfda8, 0xfda8, 0xfda8, 0xfda8, 0xfda8,
+	0xfda8, 0xfda8, 0xfda8, 0xfda8, 0xfda8, 0xfda8, 0xfda8, 0xfda8, 0xfda8, 0xfda8, 0xfda8, 0xfda8,
+	0xfda8, 0xfda8, 0x8b24, 0x0820, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0820, 0x9b85, 0xfda8, 0xfda8,
+	0xfda8, 0xfda8, 0xfda8, 0xfda8, 0xfda8, 0xfda8, 0xfda8, 0xfda8, 0xfda8, 0xfda8, 0xfda8, 0xfda8,
+	0xfda8, 0xfda8, 0xfda8, 0xfda8, 0xfda8, 0xfda8, 0x9b64, 0x0820, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0020, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0020,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0041, 0x028d, 0x0c15, 0x0c15, 0x0c15, 0x0c15, 0x0c15, 0x0c15,
+	0x0c15, 0x0c15, 0x0c15, 0x0c15, 0x0c15, 0x0c15, 0x0c15, 0x0c15, 0x0c15, 0x0c15, 0x0c15, 0x0c15,
+	0x0c15, 0x0c15, 0x028d, 0x0041, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0041, 0x0372, 0x0d7d, 0x0d7d,
+	0x0d7d, 0x2497, 0x2455, 0x0d7d, 0x0d7d, 0x0d7d, 0x0d7d, 0x0d7d, 0x0d7d, 0x0d7d, 0x0d7d, 0x0d7d,
+	0x0d7d, 0x0d7d, 0x0d7d, 0x0d7d, 0x0d7d, 0x0d7d, 0x0372, 0x0041, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0041, 0x0372, 0x0d7d, 0x0d7d, 0x0d7d, 0x1987, 0x08a2, 0x0d7d, 0x0d7d, 0x0d7d, 0x0d7d, 0x0d7d,
+	0x0cd9, 0x0cd9, 0x0d7d, 0x0d7d, 0x0d7d, 0x0d7d, 0x0d7d, 0x0d7d, 0x0d7d, 0x0d7d, 0x0372, 0x0041,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0041, 0x0372, 0x0d7d, 0x0d7d, 0x0d7d, 0x1cd8, 0x1cd8, 0x0d7d,
+	0x0d7d, 0x0d5c, 0x028d, 0x0062, 0x0000, 0x0987, 0x0d7d, 0x0d7d, 0x0d7d, 0x0d7d, 0x0d7d, 0x0d7d,
+	0x0d7d, 0x0d7d, 0x0372, 0x0041, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0041, 0x0372, 0x0d7d, 0x232f,
+	0x1104, 0x155c, 0x0d7d, 0x0d7d, 0x0d7d, 0x01c9, 0x0861, 0x79c2, 0x1040, 0x02ef, 0x0d7d, 0x0d7d,
+	0x0d7d, 0x0d7d, 0x0d7d, 0x0d7d, 0x0d7d, 0x0d7d, 0x0372, 0x0041, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0041, 0x0372, 0x0d7d, 0x2391, 0x19e8, 0x0d7c, 0x0d7d, 0x0d7d, 0x03b3, 0x0000, 0x2080, 0x28a0,
+	0x0000, 0x02ce, 0x0cfa, 0x0d7d, 0x0d7d, 0x0bf4, 0x01a8, 0x0967, 0x0310, 0x0d7d, 0x0372, 0x0041,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0041, 0x0372, 0x0d7d, 0x0d7d, 0x0d7d, 0x0d7d, 0x0c77, 0x0146,
+	0x0000, 0x0820, 0x30c0, 0x7202, 0x59a1, 0x18a1, 0x0000, 0x0a4c, 0x0331, 0x0000, 0x4921, 0x6161,
+	0x0000, 0x0c36, 0x0372, 0x0041, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0041, 0x0372, 0x0d7d, 0x0d7d,
+	0x0d7d, 0x0393, 0x0020, 0x4982, 0xd3c4, 0x69c2, 0x30c0, 0xf464, 0x38e1, 0xcb83, 0xb323, 0x20a1,
+	0x0000, 0x5941, 0xdb23, 0xcae3, 0x0000, 0x0c36, 0x0372, 0x0041, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0041, 0x0372, 0x0d7d, 0x0d7d, 0x0bb4, 0x0000, 0x8262, 0xa2a3, 0x69c1, 0x9aa2, 0x1040, 0xf464,
+	0x2080, 0x8202, 0xf464, 0xec44, 0x61c1, 0x0000, 0x81e2, 0x81e2, 0x0862, 0x0d7c, 0x0372, 0x0041,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0041, 0x0372, 0x0d7d, 0x0d7d, 0x0bb4, 0x0000, 0x8262, 0xa2a3,
+	0x69c1, 0x9aa2, 0x1040, 0xf464, 0x2080, 0x8202, 0xf464, 0xec44, 0x61c1, 0x0000, 0x81e2, 0x81e2,
+	0x0862, 0x0d7c, 0x0372, 0x0041, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0041, 0x0372, 0x0d7d, 0x0d7d,
+	0x0d7d, 0x0393, 0x0020, 0x4962, 0xd3c3, 0x69c2, 0x30c0, 0xf464, 0x38e1, 0xcb83, 0xab23, 0x20a1,
+	0x0000, 0x5941, 0xdb23, 0xcae3, 0x0000, 0x0c36, 0x0372, 0x0041, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0041, 0x0372, 0x0d7d, 0x0d7d, 0x0d7d, 0x0d7d, 0x0c77, 0x0146, 0x0000, 0x0820, 0x30c0, 0x7202,
+	0x59a1, 0x18a1, 0x0000, 0x0a6c, 0x0331, 0x0000, 0x5141, 0x6981, 0x0000, 0x0c36, 0x0372, 0x0041,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0041, 0x0372, 0x0d7d, 0x0d7d, 0x0d7d, 0x0d7d, 0x0d7d, 0x0d7d,
+	0x03b3, 0x0000, 0x2080, 0x28a0, 0x0000, 0x02ce, 0x0cfa, 0x0d7d, 0x0d7d, 0x0bf4, 0x01a8, 0x0967,
+	0x0310, 0x0d7d, 0x0372, 0x0041, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0041, 0x0372, 0x0d7d, 0x0d7d,
+	0x0d7d, 0x0d7d, 0x0d7d, 0x0d7d, 0x0d7d, 0x01c9, 0x0841, 0x79c2, 0x1040, 0x02ef, 0x0d7d, 0x0d7d,
+	0x0d1a, 0x0288, 0x0d5c, 0x0d7d, 0x0d7d, 0x0d7d, 0x0372, 0x0041, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0041, 0x0372, 0x0d7d, 0x0d7d, 0x0d7d, 0x0d7d, 0x0d7d, 0x0d7d, 0x0d7d, 0x0d5c, 0x02ae, 0x0062,
+	0x0000, 0x0987, 0x0d7d, 0x0d7d, 0x0d19, 0x0040, 0x02ca, 0x0d7d, 0x0d7d, 0x0d7d, 0x0372, 0x0041,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0041, 0x0372, 0x0d7d, 0x0d7d, 0x0d7d, 0x0d7d, 0x0d7d, 0x0d7d,
+	0x0d7d, 0x0d7d, 0x0d7d, 0x0d7d, 0x0cd9, 0x0cfa, 0x0d7d, 0x0247, 0x0454, 0x0288, 0x00e2, 0x0d7d,
+	0x0247, 0x0454, 0x0372, 0x0041, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0041, 0x0372, 0x0d7d, 0x0d7d,
+	0x0d7d, 0x0d7d, 0x0d7d, 0x0d7d, 0x0d7d, 0x0d7d, 0x0d7d, 0x0d7d, 0x0d7d, 0x0d7d, 0x0cf9, 0x0000,
+	0x03f1, 0x0226, 0x0122, 0x0cf9, 0x0000, 0x03f1, 0x0372, 0x0041, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0041, 0x0372, 0x0d7d, 0x0d7d, 0x0d7d, 0x0d7d, 0x0d7d, 0x0d7d, 0x0d7d, 0x0d7d, 0x0d7d, 0x0d7d,
+	0x0d7d, 0x0d7d, 0x030b, 0x00a1, 0x0d7c, 0x0060, 0x034d, 0x030b, 0x00a1, 0x0d7c, 0x0372, 0x0041,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0041, 0x0372, 0x0d7d, 0x0d7d, 0x0d7d, 0x0d7d, 0x0d7d, 0x0d7d,
+	0x0d7d, 0x0d7d, 0x0d7d, 0x0d7d, 0x0d7d, 0x0d7d, 0x02eb, 0x00c1, 0x0d7d, 0x0040, 0x038e, 0x02eb,
+	0x00c1, 0x0d7d, 0x0372, 0x0041, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0041, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0041, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0061, 0x022d, 0x0375, 0x0375, 0x0375, 0x0375, 0x0375, 0x0375, 0x0375, 0x0375, 0x0375, 0x0375,
+	0x0375, 0x0375, 0x0375, 0x0375, 0x0375, 0x0375, 0x0375, 0x0375, 0x0375, 0x0375, 0x022d, 0x0061,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0061, 0x0189, 0x028f, 0x028f, 0x028f, 0x028f, 0x028f, 0x028f,
+	0x028f, 0x028f, 0x028f, 0x028f, 0x028f, 0x028f, 0x028f, 0x028f, 0x028f, 0x028f, 0x028f, 0x028f,
+	0x028f, 0x028f, 0x0189, 0x0061, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0020, 0x20e2, 0x3163, 0x3163,
+	0x3163, 0x3163, 0x3163, 0x3163, 0x3163, 0x3163, 0x3163, 0x3163, 0x3163, 0x3163, 0x3163, 0x3163,
+	0x3163, 0x3163, 0x3163, 0x3163, 0x3163, 0x3163, 0x20e2, 0x0020, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0820, 0x9b85, 0xfda8, 0xfda8, 0xfda8, 0xfda8, 0xfda8, 0xfda8, 0xfda8, 0xfda8, 0xfda8, 0xfda8,
+	0xfda8, 0xfda8, 0xfda8, 0xfda8, 0xfda8, 0xfda8, 0xfda8, 0xfda8, 0xfda8, 0xfda8, 0x9b85, 0x0820,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0820, 0x9b85, 0xfda8, 0xfda8, 0xfda8, 0xfda8, 0xfda8, 0xfda8,
+	0xfda8, 0xfda8, 0xfda8, 0xfda8, 0xfda8, 0xfda8, 0xfda8, 0xfda8, 0xfda8, 0xfda8, 0xfda8, 0xfda8,
+	0xfda8, 0xfda8, 0x9b85, 0x0820, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0820, 0x9344, 0xfda8, 0xfda8,
+	0xfda8, 0xfda8, 0xfda8, 0xfda8, 0xfda8, 0xfda8, 0xfda8, 0xfda8, 0xfda8, 0xfda8, 0xfda8, 0xfda8,
+	0xfda8, 0xfda8, 0xfda8, 0xfda8, 0xfda8, 0xfda8, 0x9344, 0x0820, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x1080, 0x7ac4, 0x8304, 0x8304, 0x8304, 0x8304, 0x8304, 0x8304, 0x8304, 0x8304, 0x8304,
+	0x8304, 0x8304, 0x8304, 0x8304, 0x8304, 0x8304, 0x8304, 0x8304, 0x8304, 0x7ac4, 0x1080, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0820, 0x0820, 0x0820, 0x0820, 0x0820, 0x0820,
+	0x0820, 0x0820, 0x0820, 0x0820, 0x0820, 0x0820, 0x0820, 0x0820, 0x0820, 0x0820, 0x0820, 0x0820,
+	0x0820, 0x0820, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000,
+}
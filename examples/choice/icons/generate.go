@@ -1,6 +1,6 @@
 package icons
 
-//go:generate go run ../media ../media/aquarium.png >aquarium.go
-//go:generate go run ../media ../media/filter.png >filter.go
-//go:generate go run ../media ../media/food.png >food.go
-//go:generate go run ../media ../media/thermometer.png >thermometer.go
+//go:generate go run ../../../cmd/png2bin -o aquarium.go ../media/aquarium.png
+//go:generate go run ../../../cmd/png2bin -o filter.go ../media/filter.png
+//go:generate go run ../../../cmd/png2bin -o food.go ../media/food.png
+//go:generate go run ../../../cmd/png2bin -o thermometer.go ../media/thermometer.png
@@ -1,3 +1,5 @@
+// Code generated by png2bin from thermometer.png; DO NOT EDIT.
+
 package icons
 
 const (
@@ -5,32 +7,28 @@ const (
 	ThermometerHeight = 16
 )
 
-var (
-	ThermometerPng = []uint16{
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 17299, 44669, 44669, 17299, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 25850, 55199, 55199,
-		25850, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 25850, 55199, 53055, 25818, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 25850,
-		58905, 54712, 25818, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 25850, 58677, 56565, 25818, 0,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 25850, 58677, 56565, 25818, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 25850, 58677, 56565,
-		25818, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2211, 34203, 58677, 58677, 32155, 2211, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 0, 32123, 56694,
-		64106, 64105, 56695, 32123, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 42557, 57637, 58059, 58026, 57637, 40541,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		42557, 55588, 59489, 59489, 55589, 40509, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 32091, 54614, 63813, 63845,
-		54614, 32091, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 2244, 32123, 40509, 40509, 32091, 2244, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-		0, 0, 0, 0, 0}
-)
+// ThermometerPng holds the 16×16 image as RGB565 pixels.
+var ThermometerPng = []uint16{
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x4393, 0xae7d,
+	0xae7d, 0x4393, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x64fa, 0xd79f, 0xd79f, 0x64fa, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x64fa, 0xd79f, 0xcf3f, 0x64da, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x64fa, 0xe619,
+	0xd5b8, 0x64da, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x64fa, 0xe535, 0xdcf5, 0x64da, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x64fa, 0xe535, 0xdcf5, 0x64da, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x64fa, 0xe535,
+	0xdcf5, 0x64da, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x08a3, 0x859b, 0xe535, 0xe535, 0x7d9b, 0x08a3, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x7d7b, 0xdd76, 0xfa6a, 0xfa69, 0xdd77, 0x7d7b, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0xa63d, 0xe125, 0xe2cb,
+	0xe2aa, 0xe125, 0x9e5d, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0xa63d, 0xd924, 0xe861, 0xe861, 0xd925, 0x9e3d, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x7d5b, 0xd556, 0xf945, 0xf965, 0xd556, 0x7d5b, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x08c4, 0x7d7b, 0x9e3d,
+	0x9e3d, 0x7d5b, 0x08c4, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000, 0x0000,
+	0x0000, 0x0000, 0x0000, 0x0000,
+}
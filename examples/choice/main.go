@@ -134,16 +134,16 @@ func main() {
 		}),
 	)
 
-	iconWidgets := []ui.Widget{
-		widget.NewBitmap16(icons.AquariumWidth, icons.AquariumHeight, icons.AquariumPng),
-		widget.NewBitmap16(icons.AquariumWidth, icons.AquariumHeight, icons.FilterPng),
-		widget.NewBitmap16(icons.AquariumWidth, icons.AquariumHeight, icons.FoodPng),
-		widget.NewBitmap16(icons.AquariumWidth, icons.AquariumHeight, icons.ThermometerPng),
+	iconImages := []ui.ImageSource{
+		ui.NewRGB565Image(icons.AquariumWidth, icons.AquariumHeight, icons.AquariumPng),
+		ui.NewRGB565Image(icons.AquariumWidth, icons.AquariumHeight, icons.FilterPng),
+		ui.NewRGB565Image(icons.AquariumWidth, icons.AquariumHeight, icons.FoodPng),
+		ui.NewRGB565Image(icons.AquariumWidth, icons.AquariumHeight, icons.ThermometerPng),
 	}
 
-	iconChoice := widget.NewInteractiveWidgetChoice[ui.Widget](icons.AquariumWidth, icons.AquariumHeight, iconWidgets,
-		widget.WithWidgetChoiceIndex[ui.Widget](&iconIndex),
-		widget.WithWidgetChoiceChange(func(i int, _ ui.Widget) {
+	iconChoice := widget.NewInteractiveImageChoice(0, 0, iconImages,
+		widget.WithIconChoiceIndex(&iconIndex),
+		widget.WithImageChoiceChange(func(i int, _ ui.ImageSource) {
 			iconIndex = i
 			summaryText = updateSummary()
 		}),
@@ -170,7 +170,10 @@ func main() {
 		}
 	}
 
+	var clock ui.Clock = ui.SystemClock{}
+
 	root := container.New[ui.Widget](displayWidth, displayHeight,
+		container.WithClock[ui.Widget](clock),
 		container.WithPadding[ui.Widget](10, 10),
 		container.WithLayout[ui.Widget](layout.VList(12)),
 		container.WithChildren[ui.Widget](choices, statusLabel, summaryLabel),
@@ -189,7 +192,7 @@ func main() {
 	}
 
 	for {
-		now := time.Now()
+		now := clock.Now()
 
 		if ev := selectButton.Update(now); ev != eventNone {
 			switch ev {
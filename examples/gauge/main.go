@@ -189,7 +189,10 @@ func main() {
 
 	statusLabel := widget.NewLabel(displayWidth-20, 14, &tinyfont.TomThumb, func() string { return statusText }, color.RGBA{200, 200, 0, 255})
 
+	var clock ui.Clock = ui.SystemClock{}
+
 	root := container.New[ui.Widget](displayWidth, displayHeight,
+		container.WithClock[ui.Widget](clock),
 		container.WithPadding[ui.Widget](10, 10),
 		container.WithLayout[ui.Widget](layout.VList(10)),
 		container.WithChildren[ui.Widget](readouts, interactives, statusLabel, summaryLabel),
@@ -228,14 +231,16 @@ func main() {
 	updateStatus()
 
 	ctx := ui.NewContext(display, displayWidth, displayHeight, 0, 0)
+	renderer := ui.NewRenderer(root, &ctx)
 	draw := func() {
-		display.FillScreen(color.RGBA{0, 0, 0, 255})
-		root.Draw(&ctx)
+		if renderer.Render().Empty() {
+			return
+		}
 		_ = display.Display()
 	}
 
 	for {
-		now := time.Now()
+		now := clock.Now()
 
 		if ev := selectButton.Update(now); ev != eventNone {
 			switch ev {
@@ -0,0 +1,169 @@
+package ui
+
+import (
+	"image/color"
+
+	"tinygo.org/x/drivers"
+)
+
+var _ Displayer = (*FilterDisplayer)(nil)
+
+// FilterDisplayer maps every colour drawn inside its area through a function
+// and passes pixels outside the area through unchanged. Bitmaps overlapping
+// the area are converted row by row through a fixed line buffer.
+type FilterDisplayer struct {
+	d    drivers.Displayer
+	area Rect
+	fn   func(color.RGBA) color.RGBA
+	line [256]uint16
+}
+
+// NewFilterDisplayer filters drawing on d inside area through fn.
+func NewFilterDisplayer(d drivers.Displayer, area Rect, fn func(color.RGBA) color.RGBA) *FilterDisplayer {
+	return &FilterDisplayer{d: d, area: area, fn: fn}
+}
+
+// Reset retargets the filter, reusing the wrapper and its line buffer.
+func (f *FilterDisplayer) Reset(d drivers.Displayer, area Rect) {
+	f.d = d
+	f.area = area
+}
+
+// Unwrap returns the displayer being filtered.
+func (f *FilterDisplayer) Unwrap() drivers.Displayer { return f.d }
+
+func (f *FilterDisplayer) Size() (int16, int16) { return f.d.Size() }
+func (f *FilterDisplayer) Display() error       { return f.d.Display() }
+
+func (f *FilterDisplayer) SetPixel(x, y int16, c color.RGBA) {
+	if f.area.Contains(x, y) {
+		c = f.fn(c)
+	}
+	f.d.SetPixel(x, y, c)
+}
+
+// FillRectangle fills the filtered part with the mapped colour and the rest
+// with the original one.
+func (f *FilterDisplayer) FillRectangle(x, y, width, height int16, c color.RGBA) error {
+	r := Rect{X: x, Y: y, W: width, H: height}
+	if r.Empty() {
+		return nil
+	}
+	in := r.Intersect(f.area)
+	if in.Empty() {
+		FillRect(f.d, x, y, width, height, c)
+		return nil
+	}
+	FillRect(f.d, in.X, in.Y, in.W, in.H, f.fn(c))
+	// Up to four strips around the filtered part keep the original colour.
+	FillRect(f.d, x, y, width, in.Y-y, c)
+	FillRect(f.d, x, in.Y+in.H, width, y+height-in.Y-in.H, c)
+	FillRect(f.d, x, in.Y, in.X-x, in.H, c)
+	FillRect(f.d, in.X+in.W, in.Y, x+width-in.X-in.W, in.H, c)
+	return nil
+}
+
+func (f *FilterDisplayer) FillRectangleWithBuffer(x, y, width, height int16, buffer []color.RGBA) error {
+	if width <= 0 || height <= 0 {
+		return nil
+	}
+	if int(width)*int(height) != len(buffer) {
+		return errBufferSize
+	}
+	if fast, ok := f.d.(RectangleDisplayer); ok && !f.area.Overlaps(Rect{X: x, Y: y, W: width, H: height}) {
+		return fast.FillRectangleWithBuffer(x, y, width, height, buffer)
+	}
+	for i, c := range buffer {
+		f.SetPixel(x+int16(i%int(width)), y+int16(i/int(width)), c)
+	}
+	return nil
+}
+
+func (f *FilterDisplayer) FillScreen(c color.RGBA) {
+	w, h := f.d.Size()
+	_ = f.FillRectangle(0, 0, w, h, c)
+}
+
+func (f *FilterDisplayer) DrawFastHLine(x0, x1, y int16, c color.RGBA) {
+	if x0 > x1 {
+		x0, x1 = x1, x0
+	}
+	_ = f.FillRectangle(x0, y, x1-x0+1, 1, c)
+}
+
+func (f *FilterDisplayer) DrawFastVLine(x, y0, y1 int16, c color.RGBA) {
+	if y0 > y1 {
+		y0, y1 = y1, y0
+	}
+	_ = f.FillRectangle(x, y0, 1, y1-y0+1, c)
+}
+
+// DrawRGBBitmap draws an RGB565 bitmap, mapping the pixels inside the area.
+func (f *FilterDisplayer) DrawRGBBitmap(x, y int16, data []uint16, w, h int16) error {
+	if w <= 0 || h <= 0 {
+		return nil
+	}
+	if int(w)*int(h) != len(data) {
+		return errBufferSize
+	}
+	bmp, ok := f.d.(BitmapDisplayer)
+	if ok && !f.area.Overlaps(Rect{X: x, Y: y, W: w, H: h}) {
+		return bmp.DrawRGBBitmap(x, y, data, w, h)
+	}
+	for row := int16(0); row < h; row++ {
+		src := data[int(row)*int(w) : int(row+1)*int(w)]
+		for start := 0; start < len(src); start += len(f.line) {
+			n := copy(f.line[:], src[start:])
+			if err := f.drawRow(x+int16(start), y+row, f.line[:n]); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// DrawRGBBitmap8 draws a big-endian RGB565 byte bitmap, mapping the pixels
+// inside the area.
+func (f *FilterDisplayer) DrawRGBBitmap8(x, y int16, data []uint8, w, h int16) error {
+	if w <= 0 || h <= 0 {
+		return nil
+	}
+	if int(w)*int(h)*2 != len(data) {
+		return errBufferSize
+	}
+	bmp, ok := f.d.(BitmapDisplayer)
+	if ok && !f.area.Overlaps(Rect{X: x, Y: y, W: w, H: h}) {
+		return bmp.DrawRGBBitmap8(x, y, data, w, h)
+	}
+	stride := int(w) * 2
+	for row := int16(0); row < h; row++ {
+		src := data[int(row)*stride : int(row+1)*stride]
+		for start := 0; start < len(src); start += 2 * len(f.line) {
+			n := 0
+			for i := start; i+1 < len(src) && n < len(f.line); i += 2 {
+				f.line[n] = uint16(src[i])<<8 | uint16(src[i+1])
+				n++
+			}
+			if err := f.drawRow(x+int16(start/2), y+row, f.line[:n]); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// drawRow maps the pixels of row that fall inside the area in place and draws it.
+func (f *FilterDisplayer) drawRow(x, y int16, row []uint16) error {
+	for i, v := range row {
+		if f.area.Contains(x+int16(i), y) {
+			row[i] = RGBATo565(f.fn(RGB565ToRGBA(v)))
+		}
+	}
+	if bmp, ok := f.d.(BitmapDisplayer); ok {
+		return bmp.DrawRGBBitmap(x, y, row, int16(len(row)), 1)
+	}
+	for i, v := range row {
+		f.d.SetPixel(x+int16(i), y, RGB565ToRGBA(v))
+	}
+	return nil
+}
@@ -0,0 +1,250 @@
+package ui
+
+import (
+	"errors"
+	"image"
+	"image/color"
+)
+
+var _ Displayer = (*Framebuffer)(nil)
+
+// PixelFormat selects how a Framebuffer stores its pixels.
+type PixelFormat uint8
+
+const (
+	// PixelFormatRGB565 stores 16-bit pixels, matching most SPI TFT panels.
+	PixelFormatRGB565 PixelFormat = iota
+	// PixelFormatRGB888 stores full 24-bit colour.
+	PixelFormatRGB888
+	// PixelFormatMono stores one bit per pixel. Like SSD1306-class drivers, any
+	// non-black colour turns the pixel on.
+	PixelFormatMono
+)
+
+var errBufferSize = errors.New("buffer length does not match with rectangle size")
+
+// Framebuffer is an in-memory Displayer. It lets TinyGUI screens render on a
+// host for tests, snapshots and documentation without any hardware attached.
+type Framebuffer struct {
+	width   int16
+	height  int16
+	format  PixelFormat
+	stride  int
+	pixels  []byte
+	flushes int
+}
+
+// NewFramebuffer allocates a cleared framebuffer of the given size and format.
+func NewFramebuffer(width, height int16, format PixelFormat) *Framebuffer {
+	if width < 0 {
+		width = 0
+	}
+	if height < 0 {
+		height = 0
+	}
+	var stride int
+	switch format {
+	case PixelFormatRGB888:
+		stride = int(width) * 3
+	case PixelFormatMono:
+		stride = (int(width) + 7) / 8
+	default:
+		format = PixelFormatRGB565
+		stride = int(width) * 2
+	}
+	return &Framebuffer{
+		width:  width,
+		height: height,
+		format: format,
+		stride: stride,
+		pixels: make([]byte, stride*int(height)),
+	}
+}
+
+// Format reports the storage format.
+func (f *Framebuffer) Format() PixelFormat { return f.format }
+
+// Buffer exposes the raw pixel storage without copying.
+func (f *Framebuffer) Buffer() []byte { return f.pixels }
+
+// Flushes reports how many times Display was called.
+func (f *Framebuffer) Flushes() int { return f.flushes }
+
+// Size returns the framebuffer dimensions.
+func (f *Framebuffer) Size() (int16, int16) { return f.width, f.height }
+
+// Display only counts flushes; the framebuffer is always up to date.
+func (f *Framebuffer) Display() error {
+	f.flushes++
+	return nil
+}
+
+// SetPixel stores a colour, silently ignoring coordinates outside the buffer.
+func (f *Framebuffer) SetPixel(x, y int16, c color.RGBA) {
+	if x < 0 || y < 0 || x >= f.width || y >= f.height {
+		return
+	}
+	f.set(int(x), int(y), c)
+}
+
+// GetPixel reads back a pixel as it was quantised by the storage format.
+func (f *Framebuffer) GetPixel(x, y int16) color.RGBA {
+	if x < 0 || y < 0 || x >= f.width || y >= f.height {
+		return color.RGBA{}
+	}
+	return f.get(int(x), int(y))
+}
+
+// FillRectangle fills the part of the rectangle that lies inside the buffer.
+func (f *Framebuffer) FillRectangle(x, y, width, height int16, c color.RGBA) error {
+	x0, y0, x1, y1 := f.clip(x, y, width, height)
+	for py := y0; py < y1; py++ {
+		for px := x0; px < x1; px++ {
+			f.set(px, py, c)
+		}
+	}
+	return nil
+}
+
+// FillRectangleWithBuffer copies width*height colours into the rectangle.
+func (f *Framebuffer) FillRectangleWithBuffer(x, y, width, height int16, buffer []color.RGBA) error {
+	if width <= 0 || height <= 0 {
+		return nil
+	}
+	if int(width)*int(height) != len(buffer) {
+		return errBufferSize
+	}
+	for dy := int16(0); dy < height; dy++ {
+		row := buffer[int(dy)*int(width):]
+		for dx := int16(0); dx < width; dx++ {
+			f.SetPixel(x+dx, y+dy, row[dx])
+		}
+	}
+	return nil
+}
+
+// FillScreen paints the whole buffer with a single colour.
+func (f *Framebuffer) FillScreen(c color.RGBA) {
+	_ = f.FillRectangle(0, 0, f.width, f.height, c)
+}
+
+// DrawFastHLine draws a horizontal line between x0 and x1 inclusive.
+func (f *Framebuffer) DrawFastHLine(x0, x1, y int16, c color.RGBA) {
+	if x0 > x1 {
+		x0, x1 = x1, x0
+	}
+	_ = f.FillRectangle(x0, y, x1-x0+1, 1, c)
+}
+
+// DrawFastVLine draws a vertical line between y0 and y1 inclusive.
+func (f *Framebuffer) DrawFastVLine(x, y0, y1 int16, c color.RGBA) {
+	if y0 > y1 {
+		y0, y1 = y1, y0
+	}
+	_ = f.FillRectangle(x, y0, 1, y1-y0+1, c)
+}
+
+// DrawRGBBitmap copies RGB565 pixels into the rectangle.
+func (f *Framebuffer) DrawRGBBitmap(x, y int16, data []uint16, w, h int16) error {
+	if w <= 0 || h <= 0 {
+		return nil
+	}
+	if int(w)*int(h) != len(data) {
+		return errBufferSize
+	}
+	for dy := int16(0); dy < h; dy++ {
+		row := data[int(dy)*int(w):]
+		for dx := int16(0); dx < w; dx++ {
+			f.SetPixel(x+dx, y+dy, RGB565ToRGBA(row[dx]))
+		}
+	}
+	return nil
+}
+
+// DrawRGBBitmap8 copies big-endian RGB565 byte pairs into the rectangle, which
+// is the layout the ST7735 and ILI9341 drivers push straight to the panel.
+func (f *Framebuffer) DrawRGBBitmap8(x, y int16, data []uint8, w, h int16) error {
+	if w <= 0 || h <= 0 {
+		return nil
+	}
+	if int(w)*int(h)*2 != len(data) {
+		return errBufferSize
+	}
+	for dy := int16(0); dy < h; dy++ {
+		row := data[int(dy)*int(w)*2:]
+		for dx := int16(0); dx < w; dx++ {
+			v := uint16(row[dx*2])<<8 | uint16(row[dx*2+1])
+			f.SetPixel(x+dx, y+dy, RGB565ToRGBA(v))
+		}
+	}
+	return nil
+}
+
+// Image converts the buffer into an opaque RGBA image.
+func (f *Framebuffer) Image() *image.RGBA {
+	img := image.NewRGBA(image.Rect(0, 0, int(f.width), int(f.height)))
+	for y := 0; y < int(f.height); y++ {
+		for x := 0; x < int(f.width); x++ {
+			img.SetRGBA(x, y, f.get(x, y))
+		}
+	}
+	return img
+}
+
+func (f *Framebuffer) clip(x, y, width, height int16) (x0, y0, x1, y1 int) {
+	x0, y0 = int(x), int(y)
+	x1, y1 = x0+int(width), y0+int(height)
+	if x0 < 0 {
+		x0 = 0
+	}
+	if y0 < 0 {
+		y0 = 0
+	}
+	if x1 > int(f.width) {
+		x1 = int(f.width)
+	}
+	if y1 > int(f.height) {
+		y1 = int(f.height)
+	}
+	return x0, y0, x1, y1
+}
+
+func (f *Framebuffer) set(x, y int, c color.RGBA) {
+	switch f.format {
+	case PixelFormatRGB888:
+		i := y*f.stride + x*3
+		f.pixels[i] = c.R
+		f.pixels[i+1] = c.G
+		f.pixels[i+2] = c.B
+	case PixelFormatMono:
+		i := y*f.stride + x/8
+		mask := byte(0x80) >> (x % 8)
+		if c.R != 0 || c.G != 0 || c.B != 0 {
+			f.pixels[i] |= mask
+		} else {
+			f.pixels[i] &^= mask
+		}
+	default:
+		i := y*f.stride + x*2
+		v := RGBATo565(c)
+		f.pixels[i] = byte(v >> 8)
+		f.pixels[i+1] = byte(v)
+	}
+}
+
+func (f *Framebuffer) get(x, y int) color.RGBA {
+	switch f.format {
+	case PixelFormatRGB888:
+		i := y*f.stride + x*3
+		return color.RGBA{f.pixels[i], f.pixels[i+1], f.pixels[i+2], 0xFF}
+	case PixelFormatMono:
+		i := y*f.stride + x/8
+		if f.pixels[i]&(byte(0x80)>>(x%8)) != 0 {
+			return color.RGBA{0xFF, 0xFF, 0xFF, 0xFF}
+		}
+		return color.RGBA{0, 0, 0, 0xFF}
+	default:
+		i := y*f.stride + x*2
+		return RGB565ToRGBA(uint16(f.pixels[i])<<8 | uint16(f.pixels[i+1]))
+	}
+}
@@ -0,0 +1,29 @@
+//go:build !tinygo
+
+package ui
+
+import (
+	"image/png"
+	"io"
+	"os"
+)
+
+// The PNG writers are host-only so firmware builds do not link the encoder.
+
+// WritePNG encodes the buffer as a PNG image.
+func (f *Framebuffer) WritePNG(w io.Writer) error {
+	return png.Encode(w, f.Image())
+}
+
+// SavePNG writes the buffer to a PNG file.
+func (f *Framebuffer) SavePNG(path string) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := f.WritePNG(file); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
@@ -0,0 +1,26 @@
+//go:build !tinygo
+
+package ui_test
+
+import (
+	"bytes"
+	"image/color"
+	"image/png"
+	"testing"
+
+	ui "github.com/itohio/tinygui"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFramebufferPNG(t *testing.T) {
+	fb := ui.NewFramebuffer(3, 2, ui.PixelFormatRGB565)
+	fb.FillScreen(color.RGBA{0, 0, 0xFF, 0xFF})
+
+	var buf bytes.Buffer
+	require.NoError(t, fb.WritePNG(&buf))
+	img, err := png.Decode(&buf)
+	require.NoError(t, err)
+	require.Equal(t, 3, img.Bounds().Dx())
+	r, g, b, _ := img.At(2, 1).RGBA()
+	require.Equal(t, []uint32{0, 0, 0xFFFF}, []uint32{r, g, b})
+}
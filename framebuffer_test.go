@@ -0,0 +1,55 @@
+package ui_test
+
+import (
+	"image/color"
+	"testing"
+
+	ui "github.com/itohio/tinygui"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFramebufferFormats(t *testing.T) {
+	red := color.RGBA{0xFF, 0, 0, 0xFF}
+	grey := color.RGBA{0x81, 0x82, 0x83, 0xFF}
+
+	fb565 := ui.NewFramebuffer(4, 4, ui.PixelFormatRGB565)
+	fb565.SetPixel(1, 1, red)
+	fb565.SetPixel(2, 2, grey)
+	require.Equal(t, red, fb565.GetPixel(1, 1))
+	require.Equal(t, ui.RGB565ToRGBA(ui.RGBATo565(grey)), fb565.GetPixel(2, 2))
+
+	fb888 := ui.NewFramebuffer(4, 4, ui.PixelFormatRGB888)
+	fb888.SetPixel(2, 2, grey)
+	require.Equal(t, grey, fb888.GetPixel(2, 2))
+
+	mono := ui.NewFramebuffer(10, 2, ui.PixelFormatMono)
+	mono.SetPixel(9, 1, grey)
+	require.Equal(t, color.RGBA{0xFF, 0xFF, 0xFF, 0xFF}, mono.GetPixel(9, 1))
+	mono.SetPixel(9, 1, color.RGBA{A: 0xFF})
+	require.Equal(t, color.RGBA{0, 0, 0, 0xFF}, mono.GetPixel(9, 1))
+	require.Len(t, mono.Buffer(), 4)
+}
+
+func TestFramebufferDrawing(t *testing.T) {
+	fb := ui.NewFramebuffer(8, 8, ui.PixelFormatRGB888)
+	white := color.RGBA{0xFF, 0xFF, 0xFF, 0xFF}
+
+	require.NoError(t, fb.FillRectangle(-2, -2, 4, 4, white))
+	require.Equal(t, white, fb.GetPixel(1, 1))
+	require.Equal(t, color.RGBA{A: 0xFF}, fb.GetPixel(2, 2))
+
+	fb.DrawFastHLine(7, 5, 7, white)
+	require.Equal(t, white, fb.GetPixel(5, 7))
+	require.Equal(t, white, fb.GetPixel(7, 7))
+
+	require.Error(t, fb.DrawRGBBitmap(0, 0, make([]uint16, 3), 2, 2))
+	require.NoError(t, fb.DrawRGBBitmap(4, 0, []uint16{0xF800, 0x07E0}, 2, 1))
+	require.Equal(t, color.RGBA{0xFF, 0, 0, 0xFF}, fb.GetPixel(4, 0))
+	require.Equal(t, color.RGBA{0, 0xFF, 0, 0xFF}, fb.GetPixel(5, 0))
+
+	require.NoError(t, fb.DrawRGBBitmap8(0, 4, []uint8{0x00, 0x1F}, 1, 1))
+	require.Equal(t, color.RGBA{0, 0, 0xFF, 0xFF}, fb.GetPixel(0, 4))
+
+	require.NoError(t, fb.Display())
+	require.Equal(t, 1, fb.Flushes())
+}
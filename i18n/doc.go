@@ -0,0 +1,6 @@
+// Package i18n resolves translatable strings by StringID through the tables
+// of the active Locale. Tables are plain slices, usually generated from a CSV
+// file by cmd/i18ngen, so lookups do not allocate. Switching the locale with
+// SetLocale changes what T returns; labels built from Text providers notice the
+// different string and redraw on the next render.
+package i18n
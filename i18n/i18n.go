@@ -0,0 +1,147 @@
+package i18n
+
+// StringID identifies a translatable string. IDs index the tables of every
+// Locale, so all locales of an application list their strings in one order.
+type StringID uint16
+
+// Locale holds the translations of one language.
+type Locale struct {
+	// Tag names the locale for SetLocale, e.g. "en" or "lt".
+	Tag string
+	// Strings holds the text of every StringID; plural strings keep their
+	// first form here.
+	Strings []string
+	// Plurals holds the forms of plural strings, indexed by StringID and
+	// selected by Plural. Other IDs may be missing or nil.
+	Plurals [][]string
+	// Plural picks the form for a count; RuleFor(Tag) when nil.
+	Plural PluralRule
+	// Decimal and Group separate the fraction and thousands of formatted
+	// numbers. Zero means '.' and no grouping.
+	Decimal, Group byte
+	// Number replaces the built-in number formatting when set.
+	Number func(dst []byte, v float64, prec int) []byte
+}
+
+var (
+	locales []*Locale
+	current *Locale
+)
+
+// Register adds locales. The first locale ever registered becomes active and
+// is the fallback for strings other locales leave empty. Registering a tag
+// again replaces the earlier locale.
+func Register(ls ...*Locale) {
+	for _, l := range ls {
+		if l == nil {
+			continue
+		}
+		replaced := false
+		for i, old := range locales {
+			if old.Tag == l.Tag {
+				locales[i] = l
+				replaced = true
+				if current == old {
+					current = l
+				}
+			}
+		}
+		if !replaced {
+			locales = append(locales, l)
+		}
+		if current == nil {
+			current = l
+		}
+	}
+}
+
+// Reset unregisters every locale and restores the initial state, so tests
+// registering their own tables do not leak them into later tests.
+func Reset() {
+	locales, current = nil, nil
+}
+
+// Locales returns the registered locales in registration order.
+func Locales() []*Locale {
+	return locales
+}
+
+// SetLocale activates the locale registered under tag and reports whether it
+// exists.
+func SetLocale(tag string) bool {
+	for _, l := range locales {
+		if l.Tag == tag {
+			current = l
+			return true
+		}
+	}
+	return false
+}
+
+// Current returns the active locale, or nil before any is registered.
+func Current() *Locale {
+	return current
+}
+
+// T returns the text of id in the active locale, falling back to the first
+// registered locale and then to "".
+func T(id StringID) string {
+	if s := current.Text(id); s != "" {
+		return s
+	}
+	return fallback().Text(id)
+}
+
+// Text returns a provider looking id up on every call, for widgets that take
+// a func() string such as widget.NewLabel.
+func Text(id StringID) func() string {
+	return func() string { return T(id) }
+}
+
+// N returns the form of id for count n in the active locale. IDs without
+// plural forms return T(id).
+func N(id StringID, n int) string {
+	if s := current.PluralText(id, n); s != "" {
+		return s
+	}
+	if s := fallback().PluralText(id, n); s != "" {
+		return s
+	}
+	return T(id)
+}
+
+// Text returns the string of id, or "" when l has none.
+func (l *Locale) Text(id StringID) string {
+	if l == nil || int(id) >= len(l.Strings) {
+		return ""
+	}
+	return l.Strings[id]
+}
+
+// PluralText returns the form of id for count n, or "" when l has no plural
+// forms for id.
+func (l *Locale) PluralText(id StringID, n int) string {
+	if l == nil || int(id) >= len(l.Plurals) {
+		return ""
+	}
+	forms := l.Plurals[id]
+	if len(forms) == 0 {
+		return ""
+	}
+	rule := l.Plural
+	if rule == nil {
+		rule = RuleFor(l.Tag)
+	}
+	form := rule(n)
+	if form < 0 || form >= len(forms) {
+		form = len(forms) - 1
+	}
+	return forms[form]
+}
+
+func fallback() *Locale {
+	if len(locales) == 0 {
+		return nil
+	}
+	return locales[0]
+}
@@ -0,0 +1,85 @@
+package i18n
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	pump StringID = iota
+	items
+	missing
+)
+
+func setup(t *testing.T) {
+	t.Cleanup(Reset)
+	Register(
+		&Locale{
+			Tag:     "en",
+			Strings: []string{"Pump", "{n} item"},
+			Plurals: [][]string{nil, {"{n} item", "{n} items"}},
+			Group:   ',',
+		},
+		&Locale{
+			Tag:     "lt",
+			Strings: []string{"Siurblys", ""},
+			Plurals: [][]string{nil, {"{n} elementas", "{n} elementai", "{n} elementų"}},
+			Decimal: ',',
+			Group:   ' ',
+		},
+	)
+}
+
+func TestLookupAndSwitch(t *testing.T) {
+	setup(t)
+	label := Text(pump)
+	require.Equal(t, "Pump", label())
+
+	require.True(t, SetLocale("lt"))
+	require.Equal(t, "Siurblys", label())
+	require.Equal(t, "{n} item", T(items), "empty strings fall back to the first locale")
+	require.Equal(t, "", T(missing))
+
+	require.False(t, SetLocale("de"))
+	require.Equal(t, "lt", Current().Tag)
+}
+
+func TestPlurals(t *testing.T) {
+	setup(t)
+	require.Equal(t, "1 item", string(AppendN(nil, items, 1)))
+	require.Equal(t, "1,500 items", string(AppendN(nil, items, 1500)))
+
+	SetLocale("lt")
+	for n, want := range map[int]string{1: "1 elementas", 3: "3 elementai", 12: "12 elementų", 21: "21 elementas", 1000: "1 000 elementų"} {
+		require.Equal(t, want, string(AppendN(nil, items, n)))
+	}
+	require.Equal(t, "Siurblys", N(pump, 5))
+}
+
+func TestAppendNumber(t *testing.T) {
+	setup(t)
+	require.Equal(t, "-1,234,567.50", string(AppendNumber(nil, -1234567.5, 2)))
+	require.Equal(t, "999", string(AppendNumber(nil, 999, 0)))
+	SetLocale("lt")
+	require.Equal(t, "x=12 345,7", string(AppendNumber([]byte("x="), 12345.67, 1)))
+
+	var nilLocale *Locale
+	require.Equal(t, "2.5", string(nilLocale.AppendNumber(nil, 2.5, 1)))
+}
+
+func TestPluralRules(t *testing.T) {
+	require.Equal(t, 2, PluralEastSlavic(11))
+	require.Equal(t, 1, PluralEastSlavic(22))
+	require.Equal(t, 0, PluralNone(5))
+	require.Equal(t, 2, PluralLithuanian(0))
+	require.Equal(t, 1, RuleFor("lt-LT")(7))
+}
+
+func TestReset(t *testing.T) {
+	setup(t)
+	Reset()
+	require.Nil(t, Current())
+	require.Empty(t, Locales())
+	require.Equal(t, "", T(pump))
+}
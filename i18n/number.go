@@ -0,0 +1,87 @@
+package i18n
+
+import (
+	"strconv"
+	"strings"
+)
+
+// AppendNumber appends v rounded to prec decimals in the format of the active
+// locale.
+func AppendNumber(dst []byte, v float64, prec int) []byte {
+	return current.AppendNumber(dst, v, prec)
+}
+
+// AppendN appends the form of id for n, replacing every "{n}" with n
+// formatted for the active locale.
+func AppendN(dst []byte, id StringID, n int) []byte {
+	s := N(id, n)
+	for {
+		i := strings.Index(s, placeholder)
+		if i < 0 {
+			return append(dst, s...)
+		}
+		dst = append(dst, s[:i]...)
+		dst = AppendNumber(dst, float64(n), 0)
+		s = s[i+len(placeholder):]
+	}
+}
+
+// AppendNumber appends v rounded to prec decimals using the separators of l,
+// or its Number hook when set. A nil locale formats like strconv.
+func (l *Locale) AppendNumber(dst []byte, v float64, prec int) []byte {
+	if l != nil && l.Number != nil {
+		return l.Number(dst, v, prec)
+	}
+	start := len(dst)
+	dst = strconv.AppendFloat(dst, v, 'f', prec, 64)
+	if l == nil {
+		return dst
+	}
+	if l.Decimal != 0 && l.Decimal != '.' {
+		for i := start; i < len(dst); i++ {
+			if dst[i] == '.' {
+				dst[i] = l.Decimal
+				break
+			}
+		}
+	}
+	if l.Group != 0 {
+		dst = group(dst, start, l.Group)
+	}
+	return dst
+}
+
+// group inserts sep between thousands of the integer digits starting at
+// dst[start], in place.
+func group(dst []byte, start int, sep byte) []byte {
+	first := start
+	if first < len(dst) && dst[first] == '-' {
+		first++
+	}
+	end := first
+	for end < len(dst) && dst[end] >= '0' && dst[end] <= '9' {
+		end++
+	}
+	seps := (end - first - 1) / 3
+	if seps <= 0 {
+		return dst
+	}
+	tail := len(dst)
+	for i := 0; i < seps; i++ {
+		dst = append(dst, 0)
+	}
+	copy(dst[end+seps:], dst[end:tail])
+	w := end + seps - 1
+	for r, count := end-1, 0; r >= first; r-- {
+		dst[w] = dst[r]
+		w--
+		count++
+		if count%3 == 0 && r > first {
+			dst[w] = sep
+			w--
+		}
+	}
+	return dst
+}
+
+const placeholder = "{n}"
@@ -0,0 +1,73 @@
+package i18n
+
+// PluralRule maps a count to the index of the plural form used for it.
+type PluralRule func(n int) int
+
+// PluralOneOther uses form 0 for one and form 1 otherwise (English, German,
+// Spanish and most Western European languages).
+func PluralOneOther(n int) int {
+	if n == 1 || n == -1 {
+		return 0
+	}
+	return 1
+}
+
+// PluralNone always uses form 0 (Chinese, Japanese, Korean).
+func PluralNone(int) int {
+	return 0
+}
+
+// PluralLithuanian uses form 0 for 1, 21, 31..., form 1 for 2-9, 22-29...
+// and form 2 for 0, 10-20, 30...
+func PluralLithuanian(n int) int {
+	n = abs(n)
+	teen := n%100 >= 11 && n%100 <= 19
+	switch {
+	case n%10 == 1 && !teen:
+		return 0
+	case n%10 >= 2 && !teen:
+		return 1
+	}
+	return 2
+}
+
+// PluralEastSlavic uses form 0 for 1, 21, 31..., form 1 for 2-4, 22-24...
+// and form 2 otherwise (Russian, Ukrainian, Belarusian).
+func PluralEastSlavic(n int) int {
+	n = abs(n)
+	teen := n%100 >= 11 && n%100 <= 14
+	switch {
+	case n%10 == 1 && n%100 != 11:
+		return 0
+	case n%10 >= 2 && n%10 <= 4 && !teen:
+		return 1
+	}
+	return 2
+}
+
+// RuleFor returns the plural rule of a language tag such as "lt" or "en-GB",
+// defaulting to PluralOneOther.
+func RuleFor(tag string) PluralRule {
+	for i := 0; i < len(tag); i++ {
+		if tag[i] == '-' || tag[i] == '_' {
+			tag = tag[:i]
+			break
+		}
+	}
+	switch tag {
+	case "lt":
+		return PluralLithuanian
+	case "ru", "uk", "be":
+		return PluralEastSlavic
+	case "ja", "zh", "ko", "th", "vi", "id":
+		return PluralNone
+	}
+	return PluralOneOther
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
@@ -0,0 +1,233 @@
+package ui
+
+import (
+	"errors"
+	"image/color"
+	"strings"
+
+	"tinygo.org/x/drivers"
+	"tinygo.org/x/drivers/image/png"
+)
+
+var errNotPNG = errors.New("not a PNG image")
+
+// PixelFunc receives a w×h block of RGB565 pixels placed at x, y relative to
+// the image origin. The pixels are only valid during the call.
+type PixelFunc func(x, y, w, h int16, pixels []uint16) error
+
+// ImageSource is an image decoded on demand. Stream hands the pixels to fn in
+// blocks, usually whole rows or runs within a row; pixels a source leaves out
+// are transparent. Sources are compared by widgets to detect image changes, so
+// implementations must be comparable; the ones in this package are.
+type ImageSource interface {
+	Size() (uint16, uint16)
+	Stream(fn PixelFunc) error
+}
+
+// imageLine buffers one decoded row for sources that expand their data.
+var imageLine [256]uint16
+
+// DrawImage streams src to d at x, y, using DrawRGBBitmap when d implements
+// BitmapDisplayer and SetPixel otherwise.
+func DrawImage(d drivers.Displayer, x, y int16, src ImageSource) error {
+	if d == nil || src == nil {
+		return nil
+	}
+	bmp, fast := d.(BitmapDisplayer)
+	return src.Stream(func(bx, by, w, h int16, pixels []uint16) error {
+		if fast {
+			return bmp.DrawRGBBitmap(x+bx, y+by, pixels[:int(w)*int(h)], w, h)
+		}
+		for i := 0; i < int(w)*int(h); i++ {
+			d.SetPixel(x+bx+int16(i%int(w)), y+by+int16(i/int(w)), RGB565ToRGBA(pixels[i]))
+		}
+		return nil
+	})
+}
+
+// PNGImage is a PNG file decoded with the TinyGo PNG decoder.
+type PNGImage string
+
+// Size reads the dimensions from the PNG header, or returns zero when the
+// data is not a PNG.
+func (p PNGImage) Size() (uint16, uint16) {
+	w, h, _ := PngSize(string(p))
+	return w, h
+}
+
+// Stream decodes the image in the blocks produced by the PNG decoder.
+func (p PNGImage) Stream(fn PixelFunc) error {
+	if _, _, ok := PngSize(string(p)); !ok {
+		return errNotPNG
+	}
+	var err error
+	png.SetCallback(buffer[:], func(data []uint16, x, y, w, h, width, height int16) {
+		if err == nil {
+			err = fn(x, y, w, h, data[:int(w)*int(h)])
+		}
+	})
+	if _, decodeErr := png.Decode(strings.NewReader(string(p))); err == nil {
+		err = decodeErr
+	}
+	return err
+}
+
+// RGB565Image is a raw bitmap of RGB565 pixels, as generated by png2bin.
+type RGB565Image struct {
+	W, H   uint16
+	Pixels []uint16
+}
+
+// NewRGB565Image wraps w×h RGB565 pixels stored row by row.
+func NewRGB565Image(w, h uint16, pixels []uint16) *RGB565Image {
+	return &RGB565Image{W: w, H: h, Pixels: pixels}
+}
+
+// Size returns the bitmap dimensions.
+func (img *RGB565Image) Size() (uint16, uint16) { return img.W, img.H }
+
+// Stream hands the whole bitmap over as one block.
+func (img *RGB565Image) Stream(fn PixelFunc) error {
+	n := int(img.W) * int(img.H)
+	if n == 0 {
+		return nil
+	}
+	if len(img.Pixels) < n {
+		return errBufferSize
+	}
+	return fn(0, 0, int16(img.W), int16(img.H), img.Pixels[:n])
+}
+
+// IndexedImage is a bitmap of palette indices packed at 1, 2, 4 or 8 bits per
+// pixel, most significant bits first, with rows starting on byte boundaries.
+type IndexedImage struct {
+	W, H    uint16
+	Bpp     uint8
+	Data    []uint8
+	Palette *Palette
+}
+
+// NewIndexedImage wraps packed palette indices; see IndexedStride.
+func NewIndexedImage(w, h uint16, bpp uint8, data []uint8, p *Palette) *IndexedImage {
+	return &IndexedImage{W: w, H: h, Bpp: bpp, Data: data, Palette: p}
+}
+
+// Size returns the bitmap dimensions.
+func (img *IndexedImage) Size() (uint16, uint16) { return img.W, img.H }
+
+// Stream expands the indices through the palette one row at a time.
+func (img *IndexedImage) Stream(fn PixelFunc) error {
+	switch img.Bpp {
+	case 1, 2, 4, 8:
+	default:
+		return errBitsPerPixel
+	}
+	w, h := int16(img.W), int16(img.H)
+	stride := IndexedStride(w, img.Bpp)
+	if len(img.Data) < stride*int(h) || img.Palette == nil {
+		return errBufferSize
+	}
+	mask := uint8(1)<<img.Bpp - 1
+	for row := int16(0); row < h; row++ {
+		src := img.Data[int(row)*stride:]
+		for start := int16(0); start < w; start += int16(len(imageLine)) {
+			n := min(int16(len(imageLine)), w-start)
+			for i := int16(0); i < n; i++ {
+				imageLine[i] = img.Palette.RGB565(indexAt(src, start+i, img.Bpp, mask))
+			}
+			if err := fn(start, row, n, 1, imageLine[:n]); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// MaskImage is a 1 bit per pixel stencil, laid out like a 1bpp IndexedImage.
+// Set bits are drawn in Foreground and clear bits in Background, or left
+// transparent while Background is zero.
+type MaskImage struct {
+	W, H       uint16
+	Data       []uint8
+	Foreground color.RGBA
+	Background color.RGBA
+}
+
+// NewMaskImage wraps a packed 1bpp stencil drawn in fg on bg.
+func NewMaskImage(w, h uint16, data []uint8, fg, bg color.RGBA) *MaskImage {
+	return &MaskImage{W: w, H: h, Data: data, Foreground: fg, Background: bg}
+}
+
+// Size returns the stencil dimensions.
+func (img *MaskImage) Size() (uint16, uint16) { return img.W, img.H }
+
+// Stream emits runs of equal bits, skipping clear runs without a background.
+func (img *MaskImage) Stream(fn PixelFunc) error {
+	w, h := int16(img.W), int16(img.H)
+	stride := IndexedStride(w, 1)
+	if len(img.Data) < stride*int(h) {
+		return errBufferSize
+	}
+	colors := [2]uint16{RGBATo565(img.Background), RGBATo565(img.Foreground)}
+	transparent := img.Background == (color.RGBA{})
+	for row := int16(0); row < h; row++ {
+		src := img.Data[int(row)*stride:]
+		for x := int16(0); x < w; {
+			bit := indexAt(src, x, 1, 1)
+			n := int16(1)
+			for x+n < w && n < int16(len(imageLine)) && indexAt(src, x+n, 1, 1) == bit {
+				n++
+			}
+			if bit == 1 || !transparent {
+				line := imageLine[:n]
+				for i := range line {
+					line[i] = colors[bit]
+				}
+				if err := fn(x, row, n, 1, line); err != nil {
+					return err
+				}
+			}
+			x += n
+		}
+	}
+	return nil
+}
+
+// KeyedImage draws another source with one RGB565 colour treated as
+// transparent, e.g. the black background of a converted icon.
+type KeyedImage struct {
+	Source ImageSource
+	Key    uint16
+}
+
+// NewKeyedImage wraps src, leaving out pixels equal to key.
+func NewKeyedImage(src ImageSource, key color.RGBA) *KeyedImage {
+	return &KeyedImage{Source: src, Key: RGBATo565(key)}
+}
+
+// Size returns the dimensions of the wrapped source.
+func (img *KeyedImage) Size() (uint16, uint16) { return img.Source.Size() }
+
+// Stream splits the blocks of the wrapped source into runs of opaque pixels.
+func (img *KeyedImage) Stream(fn PixelFunc) error {
+	return img.Source.Stream(func(x, y, w, h int16, pixels []uint16) error {
+		for row := int16(0); row < h; row++ {
+			line := pixels[int(row)*int(w) : int(row+1)*int(w)]
+			for start := int16(0); start < w; {
+				if line[start] == img.Key {
+					start++
+					continue
+				}
+				end := start + 1
+				for end < w && line[end] != img.Key {
+					end++
+				}
+				if err := fn(x+start, y+row, end-start, 1, line[start:end]); err != nil {
+					return err
+				}
+				start = end
+			}
+		}
+		return nil
+	})
+}
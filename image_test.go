@@ -0,0 +1,95 @@
+package ui_test
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/png"
+	"testing"
+
+	ui "github.com/itohio/tinygui"
+	"github.com/stretchr/testify/require"
+	"tinygo.org/x/drivers"
+)
+
+// screen565 reads back a w×h framebuffer area as RGB565 values.
+func screen565(fb *ui.Framebuffer, w, h int16) []uint16 {
+	out := make([]uint16, 0, int(w)*int(h))
+	for y := int16(0); y < h; y++ {
+		for x := int16(0); x < w; x++ {
+			out = append(out, ui.RGBATo565(fb.GetPixel(x, y)))
+		}
+	}
+	return out
+}
+
+func drawSource(t *testing.T, src ui.ImageSource) *ui.Framebuffer {
+	t.Helper()
+	w, h := src.Size()
+	fb := ui.NewFramebuffer(int16(w), int16(h), ui.PixelFormatRGB565)
+	fb.FillScreen(color.RGBA{0, 0, 0xFF, 0xFF})
+	require.NoError(t, ui.DrawImage(fb, 0, 0, src))
+	return fb
+}
+
+const blue = 0x001F
+
+func TestRGB565Image(t *testing.T) {
+	pixels := []uint16{
+		1, 1, 1, 2,
+		3, 4, 4, 4,
+		4, 4, 5, 6,
+	}
+	raw := drawSource(t, ui.NewRGB565Image(4, 3, pixels))
+	require.Equal(t, pixels, screen565(raw, 4, 3))
+
+	require.Error(t, ui.DrawImage(raw, 0, 0, ui.NewRGB565Image(4, 3, pixels[:5])))
+}
+
+func TestIndexedAndMaskImages(t *testing.T) {
+	palette := ui.NewPalette(color.RGBA{0, 0, 0, 0xFF}, color.RGBA{0xFF, 0xFF, 0xFF, 0xFF}, color.RGBA{0xFF, 0, 0, 0xFF})
+	indexed := ui.NewIndexedImage(3, 2, 2, []uint8{0b00011000, 0b10010000}, palette)
+	require.Equal(t, []uint16{0, 0xFFFF, 0xF800, 0xF800, 0xFFFF, 0}, screen565(drawSource(t, indexed), 3, 2))
+
+	white := color.RGBA{0xFF, 0xFF, 0xFF, 0xFF}
+	mask := ui.NewMaskImage(4, 2, []uint8{0b10010000, 0b01100000}, white, color.RGBA{})
+	require.Equal(t, []uint16{0xFFFF, blue, blue, 0xFFFF, blue, 0xFFFF, 0xFFFF, blue}, screen565(drawSource(t, mask), 4, 2))
+
+	mask.Background = color.RGBA{0, 0, 0, 0xFF}
+	require.Equal(t, []uint16{0xFFFF, 0, 0, 0xFFFF, 0, 0xFFFF, 0xFFFF, 0}, screen565(drawSource(t, mask), 4, 2))
+}
+
+func TestKeyedImageSkipsKeyColour(t *testing.T) {
+	icon := ui.NewKeyedImage(ui.NewRGB565Image(3, 2, []uint16{0, 7, 0, 8, 0, 9}), color.RGBA{0, 0, 0, 0xFF})
+	require.Equal(t, []uint16{blue, 7, blue, 8, blue, 9}, screen565(drawSource(t, icon), 3, 2))
+}
+
+func TestPNGImage(t *testing.T) {
+	img := image.NewRGBA(image.Rect(0, 0, 2, 2))
+	for i := 0; i < 3; i++ {
+		img.Set(i%2, i/2, color.RGBA{0, 0, 0, 0xFF})
+	}
+	img.Set(1, 1, color.RGBA{0xFF, 0, 0, 0xFF})
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, img))
+
+	src := ui.PNGImage(buf.String())
+	w, h := src.Size()
+	require.Equal(t, [2]uint16{2, 2}, [2]uint16{w, h})
+	require.Equal(t, uint16(0xF800), screen565(drawSource(t, src), 2, 2)[3])
+
+	require.Error(t, ui.PNGImage("icon").Stream(func(x, y, w, h int16, pixels []uint16) error { return nil }))
+}
+
+// pixelOnly hides the bitmap methods of a displayer.
+type pixelOnly struct{ drivers.Displayer }
+
+func TestDrawImageSetPixelFallbackHandlesLargeBlocks(t *testing.T) {
+	const size = 200 // one block of 40000 pixels, beyond int16
+	pixels := make([]uint16, size*size)
+	pixels[len(pixels)-1] = 0xF800
+	fb := ui.NewFramebuffer(size, size, ui.PixelFormatRGB565)
+	require.NoError(t, ui.DrawImage(pixelOnly{fb}, 0, 0, ui.NewRGB565Image(size, size, pixels)))
+	require.Equal(t, uint16(0xF800), ui.RGBATo565(fb.GetPixel(size-1, size-1)))
+	require.Equal(t, uint16(0), ui.RGBATo565(fb.GetPixel(size-2, size-1)))
+}
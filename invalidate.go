@@ -0,0 +1,88 @@
+package ui
+
+import "image/color"
+
+// Invalidator is implemented by widgets that track whether their pixels on the
+// display are stale. WidgetBase provides it; widgets bound to external values
+// extend Dirty to compare against what was last drawn.
+type Invalidator interface {
+	Invalidate()
+	Dirty() bool
+	ClearDirty()
+}
+
+// PartialDrawer is implemented by containers that can repaint only their dirty
+// children. DrawDirty returns the display area that was repainted.
+type PartialDrawer interface {
+	DrawDirty(ctx Context, background color.RGBA) Rect
+}
+
+type childLister interface {
+	ChildCount() int
+	Child(index int) Widget
+}
+
+// NeedsRedraw reports whether w has to be repainted. Widgets that do not track
+// their state are always considered dirty.
+func NeedsRedraw(w Widget) bool {
+	if inv, ok := w.(Invalidator); ok {
+		return inv.Dirty()
+	}
+	return true
+}
+
+// MarkClean clears the dirty flag of w and all of its children.
+func MarkClean(w Widget) {
+	if inv, ok := w.(Invalidator); ok {
+		inv.ClearDirty()
+	}
+	list, ok := w.(childLister)
+	if !ok {
+		return
+	}
+	for i := 0; i < list.ChildCount(); i++ {
+		if child := list.Child(i); child != nil {
+			MarkClean(child)
+		}
+	}
+}
+
+// Redraw repaints w at the current position of ctx. The widget area is cleared
+// to background first (skipped when background is zero) and drawing is clipped
+// to the widget bounds. It returns the repainted display area.
+func Redraw(ctx Context, w Widget, background color.RGBA) Rect {
+	width, height := w.Size()
+	var child Context
+	if _, ok := ctx.(Clipper); ok {
+		child = ctx.Clone(w, width, height)
+	} else {
+		x, y := ctx.DisplayPos()
+		clip := NewClipContext(ctx.D(), width, height, x, y)
+		clip.widget = w
+		clip.theme = ContextTheme(ctx)
+		child = &clip
+	}
+	area := child.(Clipper).Clip()
+	if area.Empty() {
+		return Rect{}
+	}
+	if background != (color.RGBA{}) {
+		FillRect(child.D(), area.X, area.Y, area.W, area.H, background)
+	}
+	w.Draw(child)
+	MarkClean(w)
+	return area
+}
+
+// DrawDirty repaints the stale parts of w. Containers implementing
+// PartialDrawer descend into their children; other widgets are redrawn whole
+// when NeedsRedraw reports them dirty.
+func DrawDirty(ctx Context, w Widget, background color.RGBA) Rect {
+	if partial, ok := w.(PartialDrawer); ok {
+		return partial.DrawDirty(ctx, background)
+	}
+	if !NeedsRedraw(w) {
+		return Rect{}
+	}
+	return Redraw(ctx, w, background)
+}
@@ -0,0 +1,21 @@
+package ui
+
+// Measurer is implemented by widgets that can size themselves from their
+// content, such as text measured with its font or an image's dimensions.
+// Dimensions a widget was given explicitly are reported as-is.
+type Measurer interface {
+	// MinSize returns the smallest size the content can be shown in, for
+	// example with text truncated to an ellipsis.
+	MinSize() (width, height uint16)
+	// PreferredSize returns the size that shows the content in full.
+	PreferredSize() (width, height uint16)
+}
+
+// PreferredSize returns the preferred size of w when it is a Measurer and its
+// current size otherwise.
+func PreferredSize(w Sizer) (uint16, uint16) {
+	if m, ok := w.(Measurer); ok {
+		return m.PreferredSize()
+	}
+	return w.Size()
+}
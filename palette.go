@@ -0,0 +1,131 @@
+package ui
+
+import (
+	"errors"
+	"image/color"
+
+	"tinygo.org/x/drivers"
+)
+
+var errBitsPerPixel = errors.New("unsupported bits per pixel")
+
+// Palette maps colour indices to colours. Entries are kept in RGB565 as well
+// so indexed bitmaps expand without per-pixel conversion.
+type Palette struct {
+	colors []color.RGBA
+	rgb565 []uint16
+}
+
+// NewPalette returns a palette holding up to 256 colours.
+func NewPalette(colors ...color.RGBA) *Palette {
+	if len(colors) > 256 {
+		colors = colors[:256]
+	}
+	p := &Palette{
+		colors: append([]color.RGBA(nil), colors...),
+		rgb565: make([]uint16, len(colors)),
+	}
+	for i, c := range colors {
+		p.rgb565[i] = RGBATo565(c)
+	}
+	return p
+}
+
+// NewRGB332Palette returns the 256 colours of 8-bit RGB332 pixels (3 bits red,
+// 3 green, 2 blue), so RGB332 bitmaps draw as 8bpp indexed images.
+func NewRGB332Palette() *Palette {
+	colors := make([]color.RGBA, 256)
+	for i := range colors {
+		colors[i] = color.RGBA{
+			R: uint8((i >> 5) * 255 / 7),
+			G: uint8((i >> 2 & 7) * 255 / 7),
+			B: uint8((i & 3) * 255 / 3),
+			A: 0xFF,
+		}
+	}
+	return NewPalette(colors...)
+}
+
+// Len returns the number of entries.
+func (p *Palette) Len() int { return len(p.colors) }
+
+// Color returns entry i, or a zero colour when i is out of range.
+func (p *Palette) Color(i int) color.RGBA {
+	if i < 0 || i >= len(p.colors) {
+		return color.RGBA{}
+	}
+	return p.colors[i]
+}
+
+// RGB565 returns entry i in RGB565, or black when i is out of range.
+func (p *Palette) RGB565(i int) uint16 {
+	if i < 0 || i >= len(p.rgb565) {
+		return 0
+	}
+	return p.rgb565[i]
+}
+
+// Set replaces entry i. Widgets drawing with the palette must be invalidated
+// to pick up the change.
+func (p *Palette) Set(i int, c color.RGBA) {
+	if i < 0 || i >= len(p.colors) {
+		return
+	}
+	p.colors[i] = c
+	p.rgb565[i] = RGBATo565(c)
+}
+
+// IndexedStride returns the number of bytes in one row of a w pixel wide
+// bitmap packed at bpp bits per pixel.
+func IndexedStride(w int16, bpp uint8) int {
+	return (int(w)*int(bpp) + 7) / 8
+}
+
+var indexedLine [256]uint16
+
+// DrawIndexed draws a w×h bitmap of palette indices packed at 1, 2, 4 or 8
+// bits per pixel, most significant bits first, with every row starting on a
+// byte boundary. Rows are expanded into a fixed RGB565 line buffer, so no
+// frame-sized allocation is needed.
+func DrawIndexed(d drivers.Displayer, x, y int16, data []uint8, w, h int16, bpp uint8, p *Palette) error {
+	if w <= 0 || h <= 0 {
+		return nil
+	}
+	switch bpp {
+	case 1, 2, 4, 8:
+	default:
+		return errBitsPerPixel
+	}
+	stride := IndexedStride(w, bpp)
+	if len(data) < stride*int(h) {
+		return errBufferSize
+	}
+	bmp, fast := d.(BitmapDisplayer)
+	mask := uint8(1)<<bpp - 1
+	for row := int16(0); row < h; row++ {
+		src := data[int(row)*stride:]
+		if !fast {
+			for px := int16(0); px < w; px++ {
+				d.SetPixel(x+px, y+row, p.Color(indexAt(src, px, bpp, mask)))
+			}
+			continue
+		}
+		for start := int16(0); start < w; start += int16(len(indexedLine)) {
+			n := min16(int16(len(indexedLine)), w-start)
+			for i := int16(0); i < n; i++ {
+				indexedLine[i] = p.RGB565(indexAt(src, start+i, bpp, mask))
+			}
+			if err := bmp.DrawRGBBitmap(x+start, y+row, indexedLine[:n], n, 1); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// indexAt extracts the palette index of pixel px from a packed row.
+func indexAt(row []uint8, px int16, bpp, mask uint8) int {
+	perByte := int16(8 / bpp)
+	shift := 8 - bpp*uint8(px%perByte+1)
+	return int(row[px/perByte] >> shift & mask)
+}
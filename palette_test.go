@@ -0,0 +1,56 @@
+package ui_test
+
+import (
+	"image/color"
+	"testing"
+
+	ui "github.com/itohio/tinygui"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDrawIndexedBitDepths(t *testing.T) {
+	colors := make([]color.RGBA, 16)
+	for i := range colors {
+		colors[i] = color.RGBA{uint8(i * 16), 0, 0, 0xFF}
+	}
+	palette := ui.NewPalette(colors...)
+
+	cases := []struct {
+		bpp  uint8
+		data []uint8
+		want []int
+	}{
+		{1, []uint8{0b10100000}, []int{1, 0, 1}},
+		{2, []uint8{0b11100100}, []int{3, 2, 1}},
+		{4, []uint8{0xF0, 0x70}, []int{15, 0, 7}},
+		{8, []uint8{5, 6, 7}, []int{5, 6, 7}},
+	}
+	for _, tc := range cases {
+		fb := ui.NewFramebuffer(3, 1, ui.PixelFormatRGB888)
+		require.NoError(t, ui.DrawIndexed(fb, 0, 0, tc.data, 3, 1, tc.bpp, palette))
+		for i, idx := range tc.want {
+			want := ui.RGB565ToRGBA(palette.RGB565(idx))
+			require.Equal(t, want, fb.GetPixel(int16(i), 0), "bpp %d pixel %d", tc.bpp, i)
+		}
+	}
+
+	fb := ui.NewFramebuffer(3, 1, ui.PixelFormatRGB888)
+	require.Error(t, ui.DrawIndexed(fb, 0, 0, []uint8{0}, 3, 1, 3, palette))
+	require.Error(t, ui.DrawIndexed(fb, 0, 0, []uint8{0}, 3, 2, 8, palette))
+}
+
+func TestPaletteSet(t *testing.T) {
+	p := ui.NewPalette(color.RGBA{0, 0, 0, 0xFF})
+	p.Set(0, color.RGBA{0xFF, 0xFF, 0xFF, 0xFF})
+	require.Equal(t, uint16(0xFFFF), p.RGB565(0))
+	require.Equal(t, color.RGBA{}, p.Color(3))
+}
+
+func TestRGB332Palette(t *testing.T) {
+	p := ui.NewRGB332Palette()
+	require.Equal(t, 256, p.Len())
+	require.Equal(t, color.RGBA{0xFF, 0, 0, 0xFF}, p.Color(0b111_000_00))
+	require.Equal(t, color.RGBA{0, 0xFF, 0, 0xFF}, p.Color(0b000_111_00))
+	require.Equal(t, color.RGBA{0, 0, 0xFF, 0xFF}, p.Color(0b000_000_11))
+	require.Equal(t, color.RGBA{0xFF, 0xFF, 0xFF, 0xFF}, p.Color(0xFF))
+}
@@ -0,0 +1,73 @@
+package ui
+
+// Rect is an axis-aligned rectangle in display coordinates. Rectangles with a
+// non-positive width or height are empty.
+type Rect struct {
+	X, Y int16
+	W, H int16
+}
+
+// Empty reports whether the rectangle covers no pixels.
+func (r Rect) Empty() bool {
+	return r.W <= 0 || r.H <= 0
+}
+
+// Contains reports whether the pixel at (x,y) lies inside the rectangle.
+func (r Rect) Contains(x, y int16) bool {
+	return int32(x) >= int32(r.X) && int32(x) < int32(r.X)+int32(r.W) &&
+		int32(y) >= int32(r.Y) && int32(y) < int32(r.Y)+int32(r.H)
+}
+
+// Overlaps reports whether both rectangles share at least one pixel.
+func (r Rect) Overlaps(o Rect) bool {
+	return !r.Intersect(o).Empty()
+}
+
+// Intersect returns the area covered by both rectangles.
+func (r Rect) Intersect(o Rect) Rect {
+	x0 := max32(int32(r.X), int32(o.X))
+	y0 := max32(int32(r.Y), int32(o.Y))
+	x1 := min32(int32(r.X)+int32(r.W), int32(o.X)+int32(o.W))
+	y1 := min32(int32(r.Y)+int32(r.H), int32(o.Y)+int32(o.H))
+	if x1 <= x0 || y1 <= y0 {
+		return Rect{}
+	}
+	return Rect{X: int16(x0), Y: int16(y0), W: int16(x1 - x0), H: int16(y1 - y0)}
+}
+
+// Union returns the smallest rectangle covering both rectangles. Empty
+// rectangles are ignored.
+func (r Rect) Union(o Rect) Rect {
+	if r.Empty() {
+		return o
+	}
+	if o.Empty() {
+		return r
+	}
+	x0 := min32(int32(r.X), int32(o.X))
+	y0 := min32(int32(r.Y), int32(o.Y))
+	x1 := max32(int32(r.X)+int32(r.W), int32(o.X)+int32(o.W))
+	y1 := max32(int32(r.Y)+int32(r.H), int32(o.Y)+int32(o.H))
+	return Rect{X: int16(x0), Y: int16(y0), W: clampDim(x1 - x0), H: clampDim(y1 - y0)}
+}
+
+func clampDim(v int32) int16 {
+	if v > 0x7FFF {
+		return 0x7FFF
+	}
+	return int16(v)
+}
+
+func min32(a, b int32) int32 {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func max32(a, b int32) int32 {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,91 @@
+package ui
+
+import "image/color"
+
+// Versioned is implemented by contexts whose output can change without any
+// widget being invalidated, such as RandomContext stepping a burn-in strategy.
+type Versioned interface {
+	Version() uint32
+}
+
+// Renderer draws a widget tree incrementally. The first Render, and any Render
+// following Invalidate, repaints the whole context; later calls repaint only
+// dirty widgets and report the union of the touched areas so the application
+// can flush just that window to the panel.
+type Renderer struct {
+	root       Widget
+	ctx        Context
+	background color.RGBA
+	full       bool
+}
+
+// RendererOption customises a Renderer.
+type RendererOption func(*Renderer)
+
+// WithRendererBackground sets the colour used to clear areas before they are
+// repainted (opaque black by default). A zero colour disables clearing.
+func WithRendererBackground(col color.RGBA) RendererOption {
+	return func(r *Renderer) {
+		r.background = col
+	}
+}
+
+// NewRenderer returns a renderer drawing root into ctx.
+func NewRenderer(root Widget, ctx Context, opts ...RendererOption) *Renderer {
+	r := &Renderer{
+		root:       root,
+		ctx:        ctx,
+		background: color.RGBA{0, 0, 0, 255},
+		full:       true,
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
+// Invalidate forces the next Render to repaint everything.
+func (r *Renderer) Invalidate() {
+	r.full = true
+}
+
+// Render repaints what changed since the previous call and returns the
+// repainted display area. An empty Rect means nothing needs flushing.
+func (r *Renderer) Render() Rect {
+	if r.full {
+		return r.renderAll(r.bounds())
+	}
+	x, y := r.ctx.Start()
+	version := r.version()
+	area := DrawDirty(r.ctx, r.root, r.background)
+	if nx, ny := r.ctx.Start(); nx != x || ny != y || r.version() != version {
+		// The context moved its origin or changed its filter (e.g.
+		// RandomContext), so everything drawn before is stale.
+		w, h := r.ctx.D().Size()
+		return r.renderAll(Rect{W: w, H: h})
+	}
+	return area
+}
+
+func (r *Renderer) version() uint32 {
+	if v, ok := r.ctx.(Versioned); ok {
+		return v.Version()
+	}
+	return 0
+}
+
+func (r *Renderer) bounds() Rect {
+	x, y := r.ctx.Start()
+	w, h := r.ctx.Size()
+	return Rect{X: x, Y: y, W: clampDim(int32(w)), H: clampDim(int32(h))}
+}
+
+func (r *Renderer) renderAll(area Rect) Rect {
+	r.full = false
+	if r.background != (color.RGBA{}) {
+		FillRect(r.ctx.D(), area.X, area.Y, area.W, area.H, r.background)
+	}
+	r.root.Draw(r.ctx)
+	MarkClean(r.root)
+	return area
+}
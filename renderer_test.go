@@ -0,0 +1,96 @@
+package ui_test
+
+import (
+	"image/color"
+	"testing"
+
+	ui "github.com/itohio/tinygui"
+	"github.com/itohio/tinygui/container"
+	"github.com/itohio/tinygui/layout"
+	"github.com/stretchr/testify/require"
+)
+
+type countingWidget struct {
+	ui.WidgetBase
+	col   color.RGBA
+	draws int
+}
+
+func newCountingWidget(w, h uint16, col color.RGBA) *countingWidget {
+	return &countingWidget{WidgetBase: ui.NewWidgetBase(w, h), col: col}
+}
+
+func (c *countingWidget) Draw(ctx ui.Context) {
+	c.draws++
+	x, y := ctx.DisplayPos()
+	w, h := c.Size()
+	ui.FillRect(ctx.D(), x, y, int16(w), int16(h), c.col)
+}
+
+func (c *countingWidget) Interact(ui.UserCommand) bool { return false }
+
+func TestWidgetBaseDirtyTracking(t *testing.T) {
+	w := ui.NewWidgetBase(4, 4)
+	require.True(t, w.Dirty())
+
+	w.ClearDirty()
+	require.False(t, w.Dirty())
+
+	w.SetSelected(false)
+	require.False(t, w.Dirty())
+	w.SetSelected(true)
+	require.True(t, w.Dirty())
+}
+
+func TestRendererRedrawsOnlyDirtyChildren(t *testing.T) {
+	white := color.RGBA{0xFF, 0xFF, 0xFF, 0xFF}
+	first := newCountingWidget(10, 4, white)
+	second := newCountingWidget(10, 4, white)
+	root := container.New[ui.Widget](10, 8,
+		container.WithLayout[ui.Widget](layout.VList(0)),
+		container.WithChildren[ui.Widget](first, second),
+	)
+
+	fb := ui.NewFramebuffer(10, 8, ui.PixelFormatRGB888)
+	ctx := ui.NewContext(fb, 10, 8, 0, 0)
+	r := ui.NewRenderer(root, &ctx)
+
+	require.Equal(t, ui.Rect{W: 10, H: 8}, r.Render())
+	require.Equal(t, 1, first.draws)
+	require.Equal(t, 1, second.draws)
+	require.False(t, root.Dirty())
+
+	require.True(t, r.Render().Empty())
+	require.Equal(t, 1, first.draws)
+
+	second.col = color.RGBA{0xFF, 0, 0, 0xFF}
+	second.Invalidate()
+	require.True(t, root.Dirty())
+	require.Equal(t, ui.Rect{X: 0, Y: 4, W: 10, H: 4}, r.Render())
+	require.Equal(t, 1, first.draws)
+	require.Equal(t, 2, second.draws)
+	require.Equal(t, second.col, fb.GetPixel(0, 4))
+	require.Equal(t, white, fb.GetPixel(0, 3))
+
+	r.Invalidate()
+	require.Equal(t, ui.Rect{W: 10, H: 8}, r.Render())
+	require.Equal(t, 2, first.draws)
+}
+
+func TestRendererRepaintsScrolledViewport(t *testing.T) {
+	items := []ui.Widget{
+		newCountingWidget(10, 6, color.RGBA{0xFF, 0, 0, 0xFF}),
+		newCountingWidget(10, 6, color.RGBA{0, 0xFF, 0, 0xFF}),
+	}
+	sc := container.NewScroll(10, 8, layout.VList(0), items...)
+	fb := ui.NewFramebuffer(10, 8, ui.PixelFormatRGB888)
+	ctx := ui.NewContext(fb, 10, 8, 0, 0)
+	r := ui.NewRenderer(sc, &ctx)
+	r.Render()
+
+	require.True(t, sc.Scroll(0, 4))
+	require.Equal(t, ui.Rect{W: 10, H: 8}, r.Render())
+	require.Equal(t, color.RGBA{0xFF, 0, 0, 0xFF}, fb.GetPixel(0, 1))
+	require.Equal(t, color.RGBA{0, 0xFF, 0, 0xFF}, fb.GetPixel(0, 2))
+	require.True(t, r.Render().Empty())
+}
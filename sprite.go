@@ -0,0 +1,106 @@
+package ui
+
+import "errors"
+
+// errFrameDone stops decoding a sheet once a frame has been streamed.
+var errFrameDone = errors.New("frame done")
+
+// SpriteSheet is a grid of equally sized frames stored in one image, numbered
+// row by row from the top left. Frames are image sources themselves, so they
+// can be drawn by Icon or DrawImage.
+type SpriteSheet struct {
+	Source         ImageSource
+	FrameW, FrameH uint16
+
+	frames []SpriteFrame
+}
+
+// NewSpriteSheet splits src into frames of w×h pixels. Partial frames at the
+// right and bottom edges are ignored.
+func NewSpriteSheet(src ImageSource, w, h uint16) *SpriteSheet {
+	return &SpriteSheet{Source: src, FrameW: w, FrameH: h}
+}
+
+// Columns returns the number of frames per row.
+func (s *SpriteSheet) Columns() int {
+	if s.Source == nil || s.FrameW == 0 {
+		return 0
+	}
+	w, _ := s.Source.Size()
+	return int(w / s.FrameW)
+}
+
+// Len returns the number of frames.
+func (s *SpriteSheet) Len() int {
+	if s.Source == nil || s.FrameH == 0 {
+		return 0
+	}
+	_, h := s.Source.Size()
+	return s.Columns() * int(h/s.FrameH)
+}
+
+// Frame returns frame i, or nil when i is out of range. The same source is
+// returned on every call, so widgets see a change only when the index does.
+func (s *SpriteSheet) Frame(i int) ImageSource {
+	n := s.Len()
+	if i < 0 || i >= n {
+		return nil
+	}
+	if len(s.frames) != n {
+		s.frames = make([]SpriteFrame, n)
+		for j := range s.frames {
+			s.frames[j] = SpriteFrame{sheet: s, index: j}
+		}
+	}
+	return &s.frames[i]
+}
+
+// SpriteFrame is one frame of a SpriteSheet.
+type SpriteFrame struct {
+	sheet *SpriteSheet
+	index int
+}
+
+// Index returns the position of the frame in its sheet.
+func (f *SpriteFrame) Index() int { return f.index }
+
+// Size returns the frame dimensions.
+func (f *SpriteFrame) Size() (uint16, uint16) { return f.sheet.FrameW, f.sheet.FrameH }
+
+// Stream crops the blocks of the sheet to the frame, stopping the decoder once
+// it passes the last row of the frame.
+func (f *SpriteFrame) Stream(fn PixelFunc) error {
+	s := f.sheet
+	cols := s.Columns()
+	if cols == 0 {
+		return nil
+	}
+	fw, fh := int16(s.FrameW), int16(s.FrameH)
+	fx, fy := int16(f.index%cols)*fw, int16(f.index/cols)*fh
+	err := s.Source.Stream(func(x, y, w, h int16, pixels []uint16) error {
+		if y >= fy+fh {
+			return errFrameDone
+		}
+		x0, x1 := max(x, fx), min(x+w, fx+fw)
+		y0, y1 := max(y, fy), min(y+h, fy+fh)
+		if x0 >= x1 || y0 >= y1 {
+			return nil
+		}
+		if x0 == x && x1 == x+w {
+			// Whole rows of the block lie in the frame.
+			start := int(y0-y) * int(w)
+			return fn(x0-fx, y0-fy, w, y1-y0, pixels[start:start+int(y1-y0)*int(w)])
+		}
+		for row := y0; row < y1; row++ {
+			start := int(row-y)*int(w) + int(x0-x)
+			if err := fn(x0-fx, row-fy, x1-x0, 1, pixels[start:start+int(x1-x0)]); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err == errFrameDone {
+		return nil
+	}
+	return err
+}
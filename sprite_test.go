@@ -0,0 +1,39 @@
+package ui_test
+
+import (
+	"image/color"
+	"testing"
+
+	ui "github.com/itohio/tinygui"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSpriteSheetCropsFrames(t *testing.T) {
+	// Two columns and two rows of 2×1 frames, plus a partial column.
+	pixels := []uint16{
+		1, 2, 3, 4, 9,
+		5, 6, 7, 8, 9,
+	}
+	// The indexed copy streams row by row rather than as one block.
+	colors := make([]color.RGBA, 10)
+	for i := range colors {
+		colors[i] = ui.RGB565ToRGBA(uint16(i))
+	}
+	indices := make([]uint8, len(pixels))
+	for i, p := range pixels {
+		indices[i] = uint8(p)
+	}
+	for _, src := range []ui.ImageSource{
+		ui.NewRGB565Image(5, 2, pixels),
+		ui.NewIndexedImage(5, 2, 8, indices, ui.NewPalette(colors...)),
+	} {
+		sheet := ui.NewSpriteSheet(src, 2, 1)
+		require.Equal(t, 2, sheet.Columns())
+		require.Equal(t, 4, sheet.Len())
+		for i, want := range [][]uint16{{1, 2}, {3, 4}, {5, 6}, {7, 8}} {
+			require.Equal(t, want, screen565(drawSource(t, sheet.Frame(i)), 2, 1))
+		}
+		require.Same(t, sheet.Frame(3), sheet.Frame(3))
+		require.Nil(t, sheet.Frame(4))
+	}
+}
@@ -0,0 +1,138 @@
+package text
+
+import (
+	"image/color"
+
+	ui "github.com/itohio/tinygui"
+	"tinygo.org/x/drivers"
+	"tinygo.org/x/tinyfont"
+)
+
+// HAlign positions text horizontally inside its box.
+type HAlign uint8
+
+const (
+	AlignLeft HAlign = iota
+	AlignCenter
+	AlignRight
+)
+
+// VAlign positions a block of text vertically inside its box.
+type VAlign uint8
+
+const (
+	// AlignBaseline puts the baseline of the last line on the bottom edge of
+	// the box, which is how widgets have always drawn text.
+	AlignBaseline VAlign = iota
+	// AlignTop puts the ascent of the first line on the top edge.
+	AlignTop
+	// AlignMiddle centres the block between ascent and descent.
+	AlignMiddle
+	// AlignBottom puts the descent of the last line on the bottom edge.
+	AlignBottom
+)
+
+// Style controls how Write places text in a box. The zero value writes left
+// aligned on the bottom baseline and lets long lines overflow.
+type Style struct {
+	H HAlign
+	V VAlign
+	// Ellipsis, when set, truncates lines wider than the box and ends them
+	// with this marker, e.g. "...".
+	Ellipsis string
+}
+
+// Baseline returns the offset of the first baseline from the top of a box of
+// height h holding n lines.
+func (s Style) Baseline(m Metrics, n int, h int16) int16 {
+	if n < 1 {
+		n = 1
+	}
+	rest := int16(n-1) * m.LineHeight
+	switch s.V {
+	case AlignTop:
+		return m.Ascent
+	case AlignMiddle:
+		return (h-rest-m.Height())/2 + m.Ascent
+	case AlignBottom:
+		return h - m.Descent - rest
+	default:
+		return h - rest
+	}
+}
+
+// Indent returns the offset from the left edge of a box of width w for a line
+// of width lineWidth.
+func (s Style) Indent(lineWidth, w int16) int16 {
+	switch s.H {
+	case AlignCenter:
+		return (w - lineWidth) / 2
+	case AlignRight:
+		return w - lineWidth
+	default:
+		return 0
+	}
+}
+
+// Write draws lines inside box using style.
+func Write(d drivers.Displayer, font tinyfont.Fonter, box ui.Rect, c color.RGBA, style Style, lines ...string) {
+	if d == nil || font == nil || len(lines) == 0 {
+		return
+	}
+	// The legacy baseline placement only needs the line height, which saves
+	// scanning the font on every draw.
+	m := Metrics{LineHeight: int16(font.GetYAdvance())}
+	if style.V != AlignBaseline {
+		m = FontMetrics(font)
+	}
+	y := box.Y + style.Baseline(m, len(lines), box.H)
+	for _, line := range lines {
+		n, x, ok := place(font, box, style, line)
+		if ok {
+			tinyfont.WriteLine(d, font, x, y, line[:n], c)
+			if n < len(line) {
+				tinyfont.WriteLine(d, font, x+Width(font, line[:n]), y, style.Ellipsis, c)
+			}
+		}
+		y += m.LineHeight
+	}
+}
+
+// Extent returns the part of box that Write covers when drawing lines: from
+// the leftmost to the rightmost pen position over the full box height, which
+// also holds ascenders and descenders. Widgets clear it before redrawing.
+func Extent(font tinyfont.Fonter, box ui.Rect, style Style, lines ...string) ui.Rect {
+	var area ui.Rect
+	if font == nil {
+		return area
+	}
+	for _, line := range lines {
+		n, x, ok := place(font, box, style, line)
+		if !ok {
+			continue
+		}
+		w := Width(font, line[:n])
+		if n < len(line) {
+			w += Width(font, style.Ellipsis)
+		}
+		area = area.Union(ui.Rect{X: x, Y: box.Y, W: w, H: box.H})
+	}
+	return area
+}
+
+// place returns how many bytes of line Write draws and where the line starts.
+// It reports false when not even the ellipsis fits.
+func place(font tinyfont.Fonter, box ui.Rect, style Style, line string) (int, int16, bool) {
+	n := len(line)
+	if style.Ellipsis != "" {
+		n = Fit(font, line, box.W, style.Ellipsis)
+	}
+	w := Width(font, line[:n])
+	if n < len(line) {
+		w += Width(font, style.Ellipsis)
+		if w > box.W {
+			return 0, 0, false
+		}
+	}
+	return n, box.X + style.Indent(w, box.W), true
+}
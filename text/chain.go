@@ -0,0 +1,86 @@
+package text
+
+import "tinygo.org/x/tinyfont"
+
+// DefaultReplacement is drawn for runes no font of a Chain covers.
+const DefaultReplacement = '?'
+
+// Chain is a tinyfont.Fonter drawing every rune with the first of its fonts
+// that has a glyph for it. Runes none of them cover are drawn as the
+// Replacement rune. Chains need at least one font.
+type Chain struct {
+	Fonts       []tinyfont.Fonter
+	Replacement rune
+}
+
+var _ tinyfont.Fonter = (*Chain)(nil)
+
+// NewChain returns a chain searching fonts in order, with '?' as replacement.
+func NewChain(fonts ...tinyfont.Fonter) *Chain {
+	return &Chain{Fonts: fonts, Replacement: DefaultReplacement}
+}
+
+// GetGlyph returns the glyph of r from the first font covering it, else the
+// replacement glyph, else the first font's empty glyph.
+func (c *Chain) GetGlyph(r rune) tinyfont.Glypher {
+	if g, ok := c.find(r); ok {
+		return g
+	}
+	if c.Replacement != 0 {
+		if g, ok := c.find(c.Replacement); ok {
+			return g
+		}
+	}
+	return c.Fonts[0].GetGlyph(r)
+}
+
+// GetYAdvance returns the largest line advance of the chained fonts.
+func (c *Chain) GetYAdvance() uint8 {
+	var adv uint8
+	for _, f := range c.Fonts {
+		adv = max(adv, f.GetYAdvance())
+	}
+	return adv
+}
+
+// Covers reports whether a font of the chain has a glyph for r.
+func (c *Chain) Covers(r rune) bool {
+	_, ok := c.find(r)
+	return ok
+}
+
+func (c *Chain) find(r rune) (tinyfont.Glypher, bool) {
+	for _, f := range c.Fonts {
+		if g := f.GetGlyph(r); g.Info().Rune == r {
+			return g, true
+		}
+	}
+	return nil, false
+}
+
+// Covers reports whether font has a glyph for r. tinyfont returns an empty
+// glyph for missing runes, which is told apart by its rune.
+func Covers(font tinyfont.Fonter, r rune) bool {
+	if c, ok := font.(*Chain); ok {
+		return c.Covers(r)
+	}
+	return font.GetGlyph(r).Info().Rune == r
+}
+
+// Missing appends the runes of s that font cannot draw to dst, each once.
+// Line breaks are ignored.
+func Missing(font tinyfont.Fonter, s string, dst []rune) []rune {
+next:
+	for _, r := range s {
+		if r == '\n' || r == '\r' || Covers(font, r) {
+			continue
+		}
+		for _, seen := range dst {
+			if seen == r {
+				continue next
+			}
+		}
+		dst = append(dst, r)
+	}
+	return dst
+}
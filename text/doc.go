@@ -0,0 +1,4 @@
+// Package text measures, aligns, truncates and wraps strings drawn with
+// tinyfont. Helpers work on substrings of the input and append into
+// caller-provided slices so steady-state drawing does not allocate.
+package text
@@ -0,0 +1,94 @@
+package text
+
+import "tinygo.org/x/tinyfont"
+
+// Metrics describes the vertical extent of a font relative to its baseline.
+type Metrics struct {
+	// Ascent is the height above the baseline of the tallest glyph.
+	Ascent int16
+	// Descent is the depth below the baseline of the deepest glyph.
+	Descent int16
+	// LineHeight is the distance between consecutive baselines.
+	LineHeight int16
+}
+
+// Height returns the height of a single line of text, ascent plus descent.
+func (m Metrics) Height() int16 {
+	return m.Ascent + m.Descent
+}
+
+// FontMetrics scans the printable ASCII glyphs of font for its ascent and
+// descent. Fonts without those glyphs fall back to the line height.
+func FontMetrics(font tinyfont.Fonter) Metrics {
+	m := Metrics{LineHeight: int16(font.GetYAdvance())}
+	for r := rune(0x21); r < 0x7F; r++ {
+		info := font.GetGlyph(r).Info()
+		if info.Height == 0 {
+			continue
+		}
+		if a := -int16(info.YOffset); a > m.Ascent {
+			m.Ascent = a
+		}
+		if d := int16(info.YOffset) + int16(info.Height); d > m.Descent {
+			m.Descent = d
+		}
+	}
+	if m.Ascent == 0 && m.Descent == 0 {
+		m.Ascent = m.LineHeight
+	}
+	if m.LineHeight == 0 {
+		m.LineHeight = m.Height()
+	}
+	return m
+}
+
+// Advance returns the horizontal advance of a single rune.
+func Advance(font tinyfont.Fonter, r rune) int16 {
+	return int16(font.GetGlyph(r).Info().XAdvance)
+}
+
+// Width returns the advance width of s, the distance the pen moves when s is
+// written with tinyfont.WriteLine.
+func Width(font tinyfont.Fonter, s string) int16 {
+	w := int16(0)
+	for _, r := range s {
+		w += Advance(font, r)
+	}
+	return w
+}
+
+// Fit returns the length in bytes of the longest prefix of s that fits into
+// width together with ellipsis. When s fits as a whole len(s) is returned and
+// no ellipsis is needed. Trailing spaces are dropped from a truncated prefix.
+func Fit(font tinyfont.Fonter, s string, width int16, ellipsis string) int {
+	if Width(font, s) <= width {
+		return len(s)
+	}
+	budget := width - Width(font, ellipsis)
+	w := int16(0)
+	for i, r := range s {
+		w += Advance(font, r)
+		if w > budget {
+			// Do not leave a dangling space before the ellipsis.
+			for i > 0 && s[i-1] == ' ' {
+				i--
+			}
+			return i
+		}
+	}
+	return len(s)
+}
+
+// Truncate shortens s to fit into width, replacing the removed tail with
+// ellipsis. It returns an empty string when not even the ellipsis fits and
+// allocates only when s is actually truncated.
+func Truncate(font tinyfont.Fonter, s string, width int16, ellipsis string) string {
+	n := Fit(font, s, width, ellipsis)
+	switch {
+	case n == len(s):
+		return s
+	case Width(font, ellipsis) > width:
+		return ""
+	}
+	return s[:n] + ellipsis
+}
@@ -0,0 +1,20 @@
+package text
+
+import "tinygo.org/x/tinyfont"
+
+// Symbols is a small fallback font with the UI symbols TomThumb lacks: ° … ▲ ▶
+// ▼ ◀ ✓. Its metrics match TomThumb, so NewChain(&tinyfont.TomThumb, &Symbols)
+// draws them inline.
+var Symbols = tinyfont.Font{
+	BBox: [4]int8{5, 5, 0, -5},
+	Glyphs: []tinyfont.Glyph{
+		{Rune: 0x00B0, Width: 3, Height: 3, XAdvance: 4, XOffset: 0, YOffset: -5, Bitmaps: []byte{0x55, 0x00}},       // °
+		{Rune: 0x2026, Width: 5, Height: 1, XAdvance: 6, XOffset: 0, YOffset: -1, Bitmaps: []byte{0xA8}},             // …
+		{Rune: 0x25B2, Width: 5, Height: 3, XAdvance: 6, XOffset: 0, YOffset: -4, Bitmaps: []byte{0x23, 0xBE}},       // ▲
+		{Rune: 0x25B6, Width: 3, Height: 5, XAdvance: 4, XOffset: 0, YOffset: -5, Bitmaps: []byte{0x9B, 0xE8}},       // ▶
+		{Rune: 0x25BC, Width: 5, Height: 3, XAdvance: 6, XOffset: 0, YOffset: -4, Bitmaps: []byte{0xFB, 0x88}},       // ▼
+		{Rune: 0x25C0, Width: 3, Height: 5, XAdvance: 4, XOffset: 0, YOffset: -5, Bitmaps: []byte{0x2F, 0xB2}},       // ◀
+		{Rune: 0x2713, Width: 5, Height: 4, XAdvance: 6, XOffset: 0, YOffset: -4, Bitmaps: []byte{0x08, 0xA8, 0x80}}, // ✓
+	},
+	YAdvance: 6,
+}
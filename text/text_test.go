@@ -0,0 +1,85 @@
+package text
+
+import (
+	"image/color"
+	"testing"
+
+	ui "github.com/itohio/tinygui"
+	"github.com/stretchr/testify/require"
+	"tinygo.org/x/tinyfont"
+)
+
+var font = &tinyfont.TomThumb
+
+func TestWidthAndTruncate(t *testing.T) {
+	require.Equal(t, int16(0), Width(font, ""))
+	require.Equal(t, int16(42), Width(font, "Hello world"))
+
+	require.Equal(t, "Hello world", Truncate(font, "Hello world", 42, "..."))
+	short := Truncate(font, "Hello world", 30, "...")
+	require.Equal(t, "Hello...", short)
+	require.LessOrEqual(t, Width(font, short), int16(30))
+	require.Equal(t, "", Truncate(font, "Hello", 4, "..."))
+}
+
+func TestWrap(t *testing.T) {
+	lines := Wrap(font, "the quick brown fox\n\njumps", 40, nil)
+	require.Equal(t, []string{"the quick", "brown fox", "", "jumps"}, lines)
+
+	// Words wider than the box are split between runes.
+	lines = Wrap(font, "overthelazydog", 24, lines[:0])
+	require.Equal(t, []string{"overth", "elazyd", "og"}, lines)
+
+	require.Equal(t, []string{""}, Wrap(font, "", 24, nil))
+}
+
+func TestStylePlacement(t *testing.T) {
+	m := FontMetrics(font)
+	require.Equal(t, Metrics{Ascent: 5, Descent: 1, LineHeight: 6}, m)
+
+	require.Equal(t, int16(20), Style{}.Baseline(m, 1, 20))
+	require.Equal(t, int16(14), Style{}.Baseline(m, 2, 20))
+	require.Equal(t, int16(5), Style{V: AlignTop}.Baseline(m, 3, 20))
+	require.Equal(t, int16(12), Style{V: AlignMiddle}.Baseline(m, 1, 20))
+	require.Equal(t, int16(19), Style{V: AlignBottom}.Baseline(m, 1, 20))
+
+	require.Equal(t, int16(0), Style{}.Indent(10, 40))
+	require.Equal(t, int16(15), Style{H: AlignCenter}.Indent(10, 40))
+	require.Equal(t, int16(30), Style{H: AlignRight}.Indent(10, 40))
+}
+
+func TestWriteRightAlignedEllipsis(t *testing.T) {
+	white := color.RGBA{255, 255, 255, 255}
+	fb := ui.NewFramebuffer(40, 8, ui.PixelFormatRGB888)
+	Write(fb, font, ui.Rect{W: 40, H: 8}, white, Style{H: AlignRight, V: AlignTop, Ellipsis: "..."}, "a very long line of text")
+
+	lit := func(x0, x1 int16) bool {
+		for y := int16(0); y < 8; y++ {
+			for x := x0; x < x1; x++ {
+				if fb.GetPixel(x, y) == white {
+					return true
+				}
+			}
+		}
+		return false
+	}
+	require.True(t, lit(0, 4))
+	require.True(t, lit(36, 40))
+}
+
+func TestChainFallsBackPerRune(t *testing.T) {
+	chain := NewChain(font, &Symbols)
+	require.Equal(t, 'A', chain.GetGlyph('A').Info().Rune)
+	require.Equal(t, '▲', chain.GetGlyph('▲').Info().Rune)
+	require.Equal(t, '?', chain.GetGlyph('ж').Info().Rune)
+	require.Equal(t, font.GetYAdvance(), chain.GetYAdvance())
+
+	require.False(t, Covers(font, '✓'))
+	require.True(t, Covers(chain, '✓'))
+	require.False(t, Covers(chain, 'ж'))
+	require.Equal(t, []rune{'✓', 'ж'}, Missing(font, "ok ✓ ж✓\n", nil))
+	require.Empty(t, Missing(chain, "▲ 21°C ▼", nil))
+
+	// Replacement glyphs advance like any other so layout stays stable.
+	require.Equal(t, Width(font, "?"), Width(chain, "ж"))
+}
@@ -0,0 +1,66 @@
+package text
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"tinygo.org/x/tinyfont"
+)
+
+// Wrap splits s into lines no wider than width and appends them to lines.
+// Lines break at spaces, explicit newlines start a new line, and words wider
+// than width are split between runes. The returned lines are substrings of s.
+func Wrap(font tinyfont.Fonter, s string, width int16, lines []string) []string {
+	for {
+		para, rest, more := strings.Cut(s, "\n")
+		lines = wrapParagraph(font, para, width, lines)
+		if !more {
+			return lines
+		}
+		s = rest
+	}
+}
+
+func wrapParagraph(font tinyfont.Fonter, para string, width int16, lines []string) []string {
+	para = strings.TrimRight(para, " \r")
+	if para == "" {
+		return append(lines, "")
+	}
+	start, end := -1, -1
+	i := 0
+	for i < len(para) {
+		if para[i] == ' ' {
+			i++
+			continue
+		}
+		ws := i
+		for i < len(para) && para[i] != ' ' {
+			i++
+		}
+		if start >= 0 && Width(font, para[start:i]) <= width {
+			end = i
+			continue
+		}
+		if start >= 0 {
+			lines = append(lines, para[start:end])
+		}
+		word := para[ws:i]
+		for Width(font, word) > width {
+			n := Fit(font, word, width, "")
+			if n == 0 {
+				// Always make progress, even when a single rune is too wide.
+				_, n = utf8.DecodeRuneInString(word)
+			}
+			if n == len(word) {
+				break
+			}
+			lines = append(lines, word[:n])
+			word = word[n:]
+		}
+		start, end = i-len(word), i
+	}
+	if start >= 0 {
+		lines = append(lines, para[start:end])
+	}
+	return lines
+}
@@ -0,0 +1,205 @@
+package ui
+
+import (
+	"image/color"
+
+	"tinygo.org/x/tinyfont"
+)
+
+// Role identifies the kind of widget a theme style applies to.
+type Role uint8
+
+const (
+	// RoleText styles labels, logs and other plain text.
+	RoleText Role = iota
+	// RoleControl styles interactive controls such as toggles and choices;
+	// Accent is the "on" colour.
+	RoleControl
+	// RoleGauge styles gauges: Foreground fills, Background is the track.
+	RoleGauge
+	// RoleDecoration styles separators and focus indicators.
+	RoleDecoration
+	roleCount
+)
+
+// State is the interaction state a widget is drawn in.
+type State uint8
+
+const (
+	StateNormal State = iota
+	// StateFocused is used for selected widgets.
+	StateFocused
+	// StateActive is used while a widget is being edited.
+	StateActive
+	// StateDisabled is used for widgets whose Enabled reports false.
+	StateDisabled
+	stateCount
+)
+
+// Style holds the font, colours and spacing for one role and state. Zero
+// fields are unset.
+type Style struct {
+	Font       tinyfont.Fonter
+	Foreground color.RGBA
+	Background color.RGBA
+	Accent     color.RGBA
+	// Padding is the inset between a widget's edge and its content.
+	Padding int16
+}
+
+// merge fills unset fields of s from fallback.
+func (s Style) merge(fallback Style) Style {
+	if s.Font == nil {
+		s.Font = fallback.Font
+	}
+	if s.Foreground == (color.RGBA{}) {
+		s.Foreground = fallback.Foreground
+	}
+	if s.Background == (color.RGBA{}) {
+		s.Background = fallback.Background
+	}
+	if s.Accent == (color.RGBA{}) {
+		s.Accent = fallback.Accent
+	}
+	if s.Padding == 0 {
+		s.Padding = fallback.Padding
+	}
+	return s
+}
+
+// Theme defines a Style for every role and state. Styles for states other
+// than StateNormal only need the fields that differ; the rest is taken from
+// the role's normal style.
+type Theme struct {
+	styles [roleCount][stateCount]Style
+}
+
+// NewTheme returns a theme using base as the normal style of every role.
+func NewTheme(base Style) *Theme {
+	t := &Theme{}
+	for role := Role(0); role < roleCount; role++ {
+		t.styles[role][StateNormal] = base
+	}
+	return t
+}
+
+// Set replaces the style for role in state.
+func (t *Theme) Set(role Role, state State, style Style) *Theme {
+	if role < roleCount && state < stateCount {
+		t.styles[role][state] = style
+	}
+	return t
+}
+
+// Style returns the style for role in state, completed from the role's normal
+// style.
+func (t *Theme) Style(role Role, state State) Style {
+	if role >= roleCount || state >= stateCount {
+		return Style{}
+	}
+	normal := t.styles[role][StateNormal]
+	if state == StateNormal {
+		return normal
+	}
+	return t.styles[role][state].merge(normal)
+}
+
+// DefaultTheme is used when neither the widget tree nor the context provide a
+// theme: light text on black with TomThumb, mirroring widget defaults.
+var DefaultTheme = NewTheme(Style{
+	Font:       &tinyfont.TomThumb,
+	Foreground: color.RGBA{255, 255, 255, 255},
+	Accent:     color.RGBA{0, 200, 0, 255},
+}).
+	Set(RoleText, StateFocused, Style{Foreground: color.RGBA{255, 255, 0, 255}}).
+	Set(RoleText, StateActive, Style{Foreground: color.RGBA{0, 255, 255, 255}}).
+	Set(RoleText, StateDisabled, Style{Foreground: color.RGBA{110, 110, 110, 255}}).
+	Set(RoleControl, StateNormal, Style{
+		Font:       &tinyfont.TomThumb,
+		Foreground: color.RGBA{255, 255, 255, 255},
+		Background: color.RGBA{90, 0, 0, 255},
+		Accent:     color.RGBA{0, 120, 0, 255},
+		Padding:    2,
+	}).
+	Set(RoleControl, StateFocused, Style{Foreground: color.RGBA{255, 255, 0, 255}}).
+	Set(RoleControl, StateActive, Style{Foreground: color.RGBA{0, 255, 255, 255}}).
+	Set(RoleControl, StateDisabled, Style{
+		Foreground: color.RGBA{110, 110, 110, 255},
+		Background: color.RGBA{40, 40, 40, 255},
+		Accent:     color.RGBA{60, 60, 60, 255},
+	}).
+	Set(RoleGauge, StateNormal, Style{
+		Font:       &tinyfont.TomThumb,
+		Foreground: color.RGBA{255, 255, 255, 255},
+	}).
+	Set(RoleGauge, StateDisabled, Style{Foreground: color.RGBA{90, 90, 90, 255}}).
+	Set(RoleDecoration, StateFocused, Style{Foreground: color.RGBA{255, 255, 0, 255}}).
+	Set(RoleDecoration, StateActive, Style{Foreground: color.RGBA{0, 255, 255, 255}}).
+	Set(RoleDecoration, StateDisabled, Style{Foreground: color.RGBA{110, 110, 110, 255}})
+
+// ThemeProvider is implemented by contexts and containers that carry a theme.
+// A nil theme means "inherit".
+type ThemeProvider interface {
+	Theme() *Theme
+}
+
+// EditState is implemented by widgets that distinguish being edited from
+// merely being selected.
+type EditState interface {
+	Editing() bool
+}
+
+// ThemeOf resolves the theme for w: the nearest ancestor providing one wins,
+// then the context, then DefaultTheme.
+func ThemeOf(ctx Context, w Widget) *Theme {
+	for p := w; p != nil; p = p.Parent() {
+		if tp, ok := p.(ThemeProvider); ok {
+			if t := tp.Theme(); t != nil {
+				return t
+			}
+		}
+	}
+	if t := ContextTheme(ctx); t != nil {
+		return t
+	}
+	return DefaultTheme
+}
+
+// ContextTheme returns the theme carried by ctx, or nil.
+func ContextTheme(ctx Context) *Theme {
+	if tp, ok := ctx.(ThemeProvider); ok {
+		return tp.Theme()
+	}
+	return nil
+}
+
+// StateOf derives the interaction state of w from EnableState, EditState and
+// its selection flag. Widgets inside a disabled ancestor are disabled too.
+func StateOf(w Widget) State {
+	if !Enabled(w) {
+		return StateDisabled
+	}
+	if e, ok := w.(EditState); ok && e.Editing() {
+		return StateActive
+	}
+	if w.Selected() {
+		return StateFocused
+	}
+	return StateNormal
+}
+
+// Enabled reports whether w and all of its ancestors are enabled. Widgets not
+// implementing EnableState count as enabled.
+func Enabled(w Widget) bool {
+	for p := w; p != nil; p = p.Parent() {
+		if e, ok := p.(EnableState); ok && !e.Enabled() {
+			return false
+		}
+	}
+	return true
+}
+
+// StyleOf resolves the themed style for w in its current state.
+func StyleOf(ctx Context, w Widget, role Role) Style {
+	return ThemeOf(ctx, w).Style(role, StateOf(w))
+}
@@ -0,0 +1,94 @@
+package ui_test
+
+import (
+	"image/color"
+	"testing"
+
+	ui "github.com/itohio/tinygui"
+	"github.com/stretchr/testify/require"
+	"tinygo.org/x/tinyfont"
+)
+
+type themedWidget struct {
+	ui.WidgetBase
+	theme   *ui.Theme
+	enabled bool
+	editing bool
+}
+
+func newThemedWidget(theme *ui.Theme) *themedWidget {
+	return &themedWidget{WidgetBase: ui.NewWidgetBase(4, 4), theme: theme, enabled: true}
+}
+
+func (w *themedWidget) Draw(ui.Context)   {}
+func (w *themedWidget) Theme() *ui.Theme  { return w.theme }
+func (w *themedWidget) Enabled() bool     { return w.enabled }
+func (w *themedWidget) SetEnabled(v bool) { w.enabled = v }
+func (w *themedWidget) Editing() bool     { return w.editing }
+
+func TestThemeStyleMergesWithNormal(t *testing.T) {
+	white := color.RGBA{255, 255, 255, 255}
+	red := color.RGBA{255, 0, 0, 255}
+	theme := ui.NewTheme(ui.Style{Font: &tinyfont.TomThumb, Foreground: white, Padding: 3}).
+		Set(ui.RoleText, ui.StateFocused, ui.Style{Foreground: red})
+
+	focused := theme.Style(ui.RoleText, ui.StateFocused)
+	require.Equal(t, red, focused.Foreground)
+	require.Equal(t, int16(3), focused.Padding)
+	require.NotNil(t, focused.Font)
+
+	require.Equal(t, white, theme.Style(ui.RoleGauge, ui.StateFocused).Foreground)
+	require.Equal(t, ui.Style{}, theme.Style(ui.Role(99), ui.StateNormal))
+}
+
+func TestThemeOfPrecedence(t *testing.T) {
+	fb := ui.NewFramebuffer(8, 8, ui.PixelFormatRGB888)
+	ctx := ui.NewContext(fb, 8, 8, 0, 0)
+	child := newThemedWidget(nil)
+	require.Same(t, ui.DefaultTheme, ui.ThemeOf(&ctx, child))
+
+	root := ui.NewTheme(ui.Style{})
+	ctx.SetTheme(root)
+	require.Same(t, root, ui.ThemeOf(&ctx, child))
+
+	panel := ui.NewTheme(ui.Style{})
+	parent := newThemedWidget(panel)
+	child.SetParent(parent)
+	require.Same(t, panel, ui.ThemeOf(&ctx, child))
+
+	clone := ctx.Clone(child, 4, 4)
+	require.Same(t, root, ui.ContextTheme(clone))
+}
+
+func TestContextVersionTracksThemeChanges(t *testing.T) {
+	fb := ui.NewFramebuffer(8, 8, ui.PixelFormatRGB888)
+	ctx := ui.NewContext(fb, 8, 8, 0, 0)
+	before := ctx.Version()
+	ctx.SetTheme(ui.NewTheme(ui.Style{}))
+	require.NotEqual(t, before, ctx.Version())
+}
+
+func TestStateOf(t *testing.T) {
+	w := newThemedWidget(nil)
+	require.Equal(t, ui.StateNormal, ui.StateOf(w))
+	w.SetSelected(true)
+	require.Equal(t, ui.StateFocused, ui.StateOf(w))
+	w.editing = true
+	require.Equal(t, ui.StateActive, ui.StateOf(w))
+	w.enabled = false
+	require.Equal(t, ui.StateDisabled, ui.StateOf(w))
+}
+
+func TestStateOfInheritsDisabledAncestor(t *testing.T) {
+	parent := newThemedWidget(nil)
+	child := newThemedWidget(nil)
+	child.SetParent(parent)
+	parent.enabled = false
+	require.False(t, ui.Enabled(child))
+	require.Equal(t, ui.StateDisabled, ui.StateOf(child))
+}
+
+func TestGreyed(t *testing.T) {
+	require.Equal(t, color.RGBA{191, 191, 191, 255}, ui.Greyed(color.RGBA{255, 255, 255, 255}))
+	require.Equal(t, uint8(0x80), ui.Greyed(color.RGBA{255, 0, 0, 0x80}).A)
+}
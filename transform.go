@@ -0,0 +1,281 @@
+package ui
+
+import (
+	"image/color"
+
+	"tinygo.org/x/drivers"
+)
+
+var _ Displayer = (*TransformDisplayer)(nil)
+
+// Rotation is a clockwise rotation of the logical screen on the panel.
+type Rotation uint8
+
+const (
+	Rotate0 Rotation = iota
+	Rotate90
+	Rotate180
+	Rotate270
+)
+
+// TransformDisplayer maps logical coordinates onto a physical displayer that
+// is rotated, mirrored or scaled by an integer factor. Widgets draw in logical
+// coordinates; Size reports the logical dimensions.
+type TransformDisplayer struct {
+	d        drivers.Displayer
+	rotation Rotation
+	mirrorX  bool
+	mirrorY  bool
+	scale    int16
+	// rw, rh is the rotated (physical) space before scaling.
+	rw, rh int16
+	line   [256]uint16
+}
+
+// TransformOption configures a TransformDisplayer.
+type TransformOption func(*TransformDisplayer)
+
+// WithRotation rotates the logical screen clockwise on the panel.
+func WithRotation(r Rotation) TransformOption {
+	return func(t *TransformDisplayer) {
+		t.rotation = r % 4
+	}
+}
+
+// WithMirror flips the logical screen horizontally and/or vertically before it
+// is rotated.
+func WithMirror(horizontal, vertical bool) TransformOption {
+	return func(t *TransformDisplayer) {
+		t.mirrorX = horizontal
+		t.mirrorY = vertical
+	}
+}
+
+// WithScale draws every logical pixel as a factor×factor block.
+func WithScale(factor int16) TransformOption {
+	return func(t *TransformDisplayer) {
+		if factor > 0 {
+			t.scale = factor
+		}
+	}
+}
+
+// NewTransformDisplayer wraps d with the configured transform.
+func NewTransformDisplayer(d drivers.Displayer, opts ...TransformOption) *TransformDisplayer {
+	t := &TransformDisplayer{d: d, scale: 1}
+	for _, opt := range opts {
+		opt(t)
+	}
+	pw, ph := d.Size()
+	t.rw, t.rh = pw/t.scale, ph/t.scale
+	return t
+}
+
+// NewTransformContext returns a root context drawing through a transform of d,
+// sized to the logical screen.
+func NewTransformContext(d drivers.Displayer, opts ...TransformOption) ContextImpl {
+	t := NewTransformDisplayer(d, opts...)
+	w, h := t.Size()
+	return NewContext(t, uint16(w), uint16(h), 0, 0)
+}
+
+// Unwrap returns the physical displayer.
+func (t *TransformDisplayer) Unwrap() drivers.Displayer { return t.d }
+
+// Size returns the logical screen size.
+func (t *TransformDisplayer) Size() (int16, int16) {
+	if t.swapped() {
+		return t.rh, t.rw
+	}
+	return t.rw, t.rh
+}
+
+func (t *TransformDisplayer) Display() error { return t.d.Display() }
+
+func (t *TransformDisplayer) SetPixel(x, y int16, c color.RGBA) {
+	w, h := t.Size()
+	if x < 0 || y < 0 || x >= w || y >= h {
+		return
+	}
+	rx, ry := t.forward(x, y)
+	if t.scale == 1 {
+		t.d.SetPixel(rx, ry, c)
+		return
+	}
+	FillRect(t.d, rx*t.scale, ry*t.scale, t.scale, t.scale, c)
+}
+
+// FillRectangle fills the physical rectangle covering the logical one.
+func (t *TransformDisplayer) FillRectangle(x, y, width, height int16, c color.RGBA) error {
+	r, ok := t.physical(x, y, width, height)
+	if ok {
+		FillRect(t.d, r.X, r.Y, r.W, r.H, c)
+	}
+	return nil
+}
+
+func (t *TransformDisplayer) FillRectangleWithBuffer(x, y, width, height int16, buffer []color.RGBA) error {
+	if width <= 0 || height <= 0 {
+		return nil
+	}
+	if int(width)*int(height) != len(buffer) {
+		return errBufferSize
+	}
+	if fast, ok := t.d.(RectangleDisplayer); ok && t.identity() {
+		return fast.FillRectangleWithBuffer(x, y, width, height, buffer)
+	}
+	for i, c := range buffer {
+		t.SetPixel(x+int16(i%int(width)), y+int16(i/int(width)), c)
+	}
+	return nil
+}
+
+func (t *TransformDisplayer) FillScreen(c color.RGBA) {
+	w, h := t.Size()
+	_ = t.FillRectangle(0, 0, w, h, c)
+}
+
+func (t *TransformDisplayer) DrawFastHLine(x0, x1, y int16, c color.RGBA) {
+	if x0 > x1 {
+		x0, x1 = x1, x0
+	}
+	_ = t.FillRectangle(x0, y, x1-x0+1, 1, c)
+}
+
+func (t *TransformDisplayer) DrawFastVLine(x, y0, y1 int16, c color.RGBA) {
+	if y0 > y1 {
+		y0, y1 = y1, y0
+	}
+	_ = t.FillRectangle(x, y0, 1, y1-y0+1, c)
+}
+
+// DrawRGBBitmap reorders and scales an RGB565 bitmap into physical rows.
+func (t *TransformDisplayer) DrawRGBBitmap(x, y int16, data []uint16, w, h int16) error {
+	if w <= 0 || h <= 0 {
+		return nil
+	}
+	if int(w)*int(h) != len(data) {
+		return errBufferSize
+	}
+	if bmp, ok := t.d.(BitmapDisplayer); ok && t.identity() {
+		return bmp.DrawRGBBitmap(x, y, data, w, h)
+	}
+	return t.drawBitmap(x, y, w, h, data, nil)
+}
+
+// DrawRGBBitmap8 reorders and scales a big-endian RGB565 byte bitmap.
+func (t *TransformDisplayer) DrawRGBBitmap8(x, y int16, data []uint8, w, h int16) error {
+	if w <= 0 || h <= 0 {
+		return nil
+	}
+	if int(w)*int(h)*2 != len(data) {
+		return errBufferSize
+	}
+	if bmp, ok := t.d.(BitmapDisplayer); ok && t.identity() {
+		return bmp.DrawRGBBitmap8(x, y, data, w, h)
+	}
+	return t.drawBitmap(x, y, w, h, nil, data)
+}
+
+// drawBitmap walks the physical rectangle covered by the bitmap row by row,
+// looking every physical pixel up in the logical source (data16 or data8).
+func (t *TransformDisplayer) drawBitmap(x, y, w, h int16, data16 []uint16, data8 []uint8) error {
+	r, ok := t.physical(x, y, w, h)
+	if !ok {
+		return nil
+	}
+	bmp, fast := t.d.(BitmapDisplayer)
+	for py := r.Y; py < r.Y+r.H; py++ {
+		for start := r.X; start < r.X+r.W; start += int16(len(t.line)) {
+			n := min16(int16(len(t.line)), r.X+r.W-start)
+			for i := int16(0); i < n; i++ {
+				lx, ly := t.inverse((start+i)/t.scale, py/t.scale)
+				idx := int(ly-y)*int(w) + int(lx-x)
+				if data16 != nil {
+					t.line[i] = data16[idx]
+				} else {
+					t.line[i] = uint16(data8[2*idx])<<8 | uint16(data8[2*idx+1])
+				}
+			}
+			if fast {
+				if err := bmp.DrawRGBBitmap(start, py, t.line[:n], n, 1); err != nil {
+					return err
+				}
+				continue
+			}
+			for i := int16(0); i < n; i++ {
+				t.d.SetPixel(start+i, py, RGB565ToRGBA(t.line[i]))
+			}
+		}
+	}
+	return nil
+}
+
+// physical clips a logical rectangle to the screen and returns the physical
+// rectangle covering it.
+func (t *TransformDisplayer) physical(x, y, width, height int16) (Rect, bool) {
+	lw, lh := t.Size()
+	r := Rect{X: x, Y: y, W: width, H: height}.Intersect(Rect{W: lw, H: lh})
+	if r.Empty() {
+		return Rect{}, false
+	}
+	x0, y0 := t.forward(r.X, r.Y)
+	x1, y1 := t.forward(r.X+r.W-1, r.Y+r.H-1)
+	if x0 > x1 {
+		x0, x1 = x1, x0
+	}
+	if y0 > y1 {
+		y0, y1 = y1, y0
+	}
+	s := t.scale
+	return Rect{X: x0 * s, Y: y0 * s, W: (x1 - x0 + 1) * s, H: (y1 - y0 + 1) * s}, true
+}
+
+// forward maps a logical pixel to the rotated space: mirror, then rotate.
+func (t *TransformDisplayer) forward(x, y int16) (int16, int16) {
+	lw, lh := t.Size()
+	if t.mirrorX {
+		x = lw - 1 - x
+	}
+	if t.mirrorY {
+		y = lh - 1 - y
+	}
+	switch t.rotation {
+	case Rotate90:
+		return t.rw - 1 - y, x
+	case Rotate180:
+		return lw - 1 - x, lh - 1 - y
+	case Rotate270:
+		return y, t.rh - 1 - x
+	}
+	return x, y
+}
+
+// inverse maps a rotated-space pixel back to the logical screen.
+func (t *TransformDisplayer) inverse(rx, ry int16) (int16, int16) {
+	lw, lh := t.Size()
+	x, y := rx, ry
+	switch t.rotation {
+	case Rotate90:
+		x, y = ry, t.rw-1-rx
+	case Rotate180:
+		x, y = lw-1-rx, lh-1-ry
+	case Rotate270:
+		x, y = t.rh-1-ry, rx
+	}
+	if t.mirrorX {
+		x = lw - 1 - x
+	}
+	if t.mirrorY {
+		y = lh - 1 - y
+	}
+	return x, y
+}
+
+func (t *TransformDisplayer) swapped() bool {
+	return t.rotation == Rotate90 || t.rotation == Rotate270
+}
+
+func (t *TransformDisplayer) identity() bool {
+	return t.rotation == Rotate0 && !t.mirrorX && !t.mirrorY && t.scale == 1
+}
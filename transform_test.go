@@ -0,0 +1,58 @@
+package ui_test
+
+import (
+	"image/color"
+	"testing"
+
+	ui "github.com/itohio/tinygui"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransformSizeFollowsRotation(t *testing.T) {
+	fb := ui.NewFramebuffer(40, 20, ui.PixelFormatRGB565)
+
+	ctx := ui.NewTransformContext(fb, ui.WithRotation(ui.Rotate90), ui.WithScale(2))
+	w, h := ctx.Size()
+	require.Equal(t, uint16(10), w)
+	require.Equal(t, uint16(20), h)
+}
+
+func TestTransformMapsPixels(t *testing.T) {
+	white := color.RGBA{0xFF, 0xFF, 0xFF, 0xFF}
+	fb := ui.NewFramebuffer(4, 3, ui.PixelFormatRGB888)
+
+	d := ui.NewTransformDisplayer(fb, ui.WithRotation(ui.Rotate90))
+	d.SetPixel(0, 0, white)
+	require.Equal(t, white, fb.GetPixel(3, 0))
+
+	fb.FillScreen(color.RGBA{})
+	d = ui.NewTransformDisplayer(fb, ui.WithMirror(true, false))
+	d.SetPixel(0, 2, white)
+	require.Equal(t, white, fb.GetPixel(3, 2))
+
+	fb = ui.NewFramebuffer(6, 6, ui.PixelFormatRGB888)
+	d = ui.NewTransformDisplayer(fb, ui.WithScale(3))
+	require.NoError(t, d.FillRectangle(1, 1, 1, 1, white))
+	require.Equal(t, white, fb.GetPixel(3, 3))
+	require.Equal(t, white, fb.GetPixel(5, 5))
+	require.NotEqual(t, white, fb.GetPixel(2, 2))
+}
+
+func TestTransformBitmapMatchesSetPixel(t *testing.T) {
+	const w, h = 3, 2
+	data := []uint16{0xF800, 0x07E0, 0x001F, 0xFFE0, 0x07FF, 0xF81F}
+	for _, rot := range []ui.Rotation{ui.Rotate0, ui.Rotate90, ui.Rotate180, ui.Rotate270} {
+		for _, mirror := range []bool{false, true} {
+			opts := []ui.TransformOption{ui.WithRotation(rot), ui.WithMirror(mirror, false), ui.WithScale(2)}
+			want := ui.NewFramebuffer(16, 16, ui.PixelFormatRGB565)
+			got := ui.NewFramebuffer(16, 16, ui.PixelFormatRGB565)
+
+			ref := ui.NewTransformDisplayer(want, opts...)
+			for i, v := range data {
+				ref.SetPixel(int16(1+i%w), int16(2+i/w), ui.RGB565ToRGBA(v))
+			}
+			require.NoError(t, ui.NewTransformDisplayer(got, opts...).DrawRGBBitmap(1, 2, data, w, h))
+			require.Equal(t, want.Buffer(), got.Buffer(), "rotation %d mirror %v", rot, mirror)
+		}
+	}
+}
@@ -0,0 +1,205 @@
+// Package uitest renders TinyGUI widget trees into an offscreen Framebuffer and
+// compares the result against checked-in golden PNG images.
+//
+// Goldens live in testdata/<name>.png next to the test. Run the tests with
+// -update to rewrite them after an intentional rendering change. On mismatch a
+// diff image (<name>.diff.png) highlighting changed pixels in red is written
+// alongside the golden.
+package uitest
+
+import (
+	"flag"
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"os"
+	"path/filepath"
+	"testing"
+
+	ui "github.com/itohio/tinygui"
+)
+
+var update = flag.Bool("update", false, "rewrite uitest golden images")
+
+type config struct {
+	format     ui.PixelFormat
+	background color.RGBA
+	dir        string
+	maxPixels  int
+	delta      uint8
+	update     bool
+}
+
+// Option customises rendering and comparison.
+type Option func(*config)
+
+// WithFormat selects the framebuffer pixel format (RGB565 by default).
+func WithFormat(format ui.PixelFormat) Option {
+	return func(c *config) {
+		c.format = format
+	}
+}
+
+// WithBackground sets the colour the framebuffer is cleared to before drawing.
+func WithBackground(col color.RGBA) Option {
+	return func(c *config) {
+		c.background = col
+	}
+}
+
+// WithGoldenDir overrides the directory holding golden images (testdata by default).
+func WithGoldenDir(dir string) Option {
+	return func(c *config) {
+		c.dir = dir
+	}
+}
+
+// WithTolerance accepts up to maxPixels differing pixels, where a pixel only
+// counts as different when a channel deviates by more than delta.
+func WithTolerance(maxPixels int, delta uint8) Option {
+	return func(c *config) {
+		c.maxPixels = maxPixels
+		c.delta = delta
+	}
+}
+
+// WithUpdate forces golden images to be rewritten regardless of the -update flag.
+func WithUpdate() Option {
+	return func(c *config) {
+		c.update = true
+	}
+}
+
+func newConfig(opts []Option) config {
+	cfg := config{
+		format:     ui.PixelFormatRGB565,
+		background: color.RGBA{0, 0, 0, 255},
+		dir:        "testdata",
+		update:     *update,
+	}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	return cfg
+}
+
+// Render draws w into a framebuffer sized to the widget.
+func Render(w ui.Widget, opts ...Option) *ui.Framebuffer {
+	width, height := w.Size()
+	return RenderSize(w, width, height, opts...)
+}
+
+// RenderSize draws w into a framebuffer of the given size through a root context.
+func RenderSize(w ui.Widget, width, height uint16, opts ...Option) *ui.Framebuffer {
+	cfg := newConfig(opts)
+	fb := ui.NewFramebuffer(int16(width), int16(height), cfg.format)
+	fb.FillScreen(cfg.background)
+	ctx := ui.NewContext(fb, width, height, 0, 0)
+	w.Draw(&ctx)
+	return fb
+}
+
+// Snapshot renders w and compares it against the golden image called name.
+func Snapshot(t testing.TB, name string, w ui.Widget, opts ...Option) *ui.Framebuffer {
+	t.Helper()
+	fb := Render(w, opts...)
+	AssertGolden(t, name, fb, opts...)
+	return fb
+}
+
+// AssertGolden compares fb against testdata/<name>.png, rewriting the golden
+// when updating and writing a diff image when the comparison fails.
+func AssertGolden(t testing.TB, name string, fb *ui.Framebuffer, opts ...Option) {
+	t.Helper()
+	cfg := newConfig(opts)
+	goldenPath := filepath.Join(cfg.dir, name+".png")
+	diffPath := filepath.Join(cfg.dir, name+".diff.png")
+	actual := fb.Image()
+
+	if cfg.update {
+		if err := os.MkdirAll(cfg.dir, 0o755); err != nil {
+			t.Fatalf("uitest: %v", err)
+		}
+		if err := writePNG(goldenPath, actual); err != nil {
+			t.Fatalf("uitest: %v", err)
+		}
+		_ = os.Remove(diffPath)
+		return
+	}
+
+	expected, err := readPNG(goldenPath)
+	if err != nil {
+		t.Fatalf("uitest: golden %s unavailable (run with -update to create it): %v", goldenPath, err)
+	}
+
+	count, diff := Diff(expected, actual, cfg.delta)
+	if count < 0 {
+		t.Fatalf("uitest: %s size mismatch: golden %v, rendered %v", name, expected.Bounds().Size(), actual.Bounds().Size())
+	}
+	if count <= cfg.maxPixels {
+		_ = os.Remove(diffPath)
+		return
+	}
+	if err := writePNG(diffPath, diff); err != nil {
+		t.Errorf("uitest: writing diff: %v", err)
+	}
+	t.Errorf("uitest: %s differs from golden in %d pixels (tolerance %d); diff written to %s", name, count, cfg.maxPixels, diffPath)
+}
+
+// Diff counts pixels whose channels differ by more than delta and returns an
+// image where unchanged pixels are a faded copy of expected and changed pixels
+// are red. A negative count reports mismatching image sizes.
+func Diff(expected, actual image.Image, delta uint8) (int, *image.RGBA) {
+	bounds := expected.Bounds()
+	if bounds.Size() != actual.Bounds().Size() {
+		return -1, nil
+	}
+	out := image.NewRGBA(image.Rect(0, 0, bounds.Dx(), bounds.Dy()))
+	offset := actual.Bounds().Min.Sub(bounds.Min)
+	count := 0
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			e := color.RGBAModel.Convert(expected.At(x, y)).(color.RGBA)
+			a := color.RGBAModel.Convert(actual.At(x+offset.X, y+offset.Y)).(color.RGBA)
+			px, py := x-bounds.Min.X, y-bounds.Min.Y
+			if channelDiff(e.R, a.R) > delta || channelDiff(e.G, a.G) > delta ||
+				channelDiff(e.B, a.B) > delta || channelDiff(e.A, a.A) > delta {
+				count++
+				out.SetRGBA(px, py, color.RGBA{0xFF, 0, 0, 0xFF})
+				continue
+			}
+			grey := uint8((uint16(e.R) + uint16(e.G) + uint16(e.B)) / 3 / 4)
+			out.SetRGBA(px, py, color.RGBA{grey, grey, grey, 0xFF})
+		}
+	}
+	return count, out
+}
+
+func channelDiff(a, b uint8) uint8 {
+	if a > b {
+		return a - b
+	}
+	return b - a
+}
+
+func readPNG(path string) (image.Image, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	return png.Decode(file)
+}
+
+func writePNG(path string, img image.Image) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := png.Encode(file, img); err != nil {
+		file.Close()
+		return fmt.Errorf("encoding %s: %w", path, err)
+	}
+	return file.Close()
+}
@@ -0,0 +1,86 @@
+package uitest
+
+import (
+	"fmt"
+	"image/color"
+	"os"
+	"path/filepath"
+	"testing"
+
+	ui "github.com/itohio/tinygui"
+	"github.com/stretchr/testify/require"
+)
+
+type boxWidget struct {
+	ui.WidgetBase
+	col color.RGBA
+}
+
+func newBoxWidget(w, h uint16, col color.RGBA) *boxWidget {
+	return &boxWidget{WidgetBase: ui.NewWidgetBase(w, h), col: col}
+}
+
+func (b *boxWidget) Draw(ctx ui.Context) {
+	x, y := ctx.DisplayPos()
+	d := ctx.D()
+	for dy := int16(1); dy < int16(b.Height)-1; dy++ {
+		ui.HLine(d, x+1, y+dy, int16(b.Width)-2, b.col)
+	}
+}
+
+type recordingTB struct {
+	testing.TB
+	errors []string
+}
+
+func (r *recordingTB) Helper() {}
+
+func (r *recordingTB) Errorf(format string, args ...any) {
+	r.errors = append(r.errors, fmt.Sprintf(format, args...))
+}
+
+func TestRenderDrawsWidget(t *testing.T) {
+	fb := Render(newBoxWidget(6, 4, color.RGBA{0, 0xFF, 0, 0xFF}), WithFormat(ui.PixelFormatRGB888))
+	w, h := fb.Size()
+	require.Equal(t, int16(6), w)
+	require.Equal(t, int16(4), h)
+	require.Equal(t, color.RGBA{0, 0, 0, 0xFF}, fb.GetPixel(0, 0))
+	require.Equal(t, color.RGBA{0, 0xFF, 0, 0xFF}, fb.GetPixel(1, 1))
+}
+
+func TestSnapshotUpdateAndCompare(t *testing.T) {
+	dir := t.TempDir()
+	box := newBoxWidget(8, 8, color.RGBA{0xFF, 0xFF, 0xFF, 0xFF})
+
+	Snapshot(t, "box", box, WithGoldenDir(dir), WithUpdate())
+	require.FileExists(t, filepath.Join(dir, "box.png"))
+
+	Snapshot(t, "box", box, WithGoldenDir(dir))
+
+	rec := &recordingTB{TB: t}
+	box.col = color.RGBA{0xFF, 0, 0, 0xFF}
+	Snapshot(rec, "box", box, WithGoldenDir(dir))
+	require.Len(t, rec.errors, 1)
+	require.FileExists(t, filepath.Join(dir, "box.diff.png"))
+
+	rec.errors = nil
+	Snapshot(rec, "box", box, WithGoldenDir(dir), WithTolerance(36, 0))
+	require.Empty(t, rec.errors)
+	_, err := os.Stat(filepath.Join(dir, "box.diff.png"))
+	require.True(t, os.IsNotExist(err))
+}
+
+func TestDiffTolerance(t *testing.T) {
+	a := ui.NewFramebuffer(2, 1, ui.PixelFormatRGB888)
+	b := ui.NewFramebuffer(2, 1, ui.PixelFormatRGB888)
+	b.SetPixel(0, 0, color.RGBA{3, 0, 0, 0xFF})
+	b.SetPixel(1, 0, color.RGBA{40, 0, 0, 0xFF})
+
+	count, diff := Diff(a.Image(), b.Image(), 4)
+	require.Equal(t, 1, count)
+	require.Equal(t, color.RGBA{0xFF, 0, 0, 0xFF}, diff.RGBAAt(1, 0))
+
+	c := ui.NewFramebuffer(3, 1, ui.PixelFormatRGB888)
+	count, _ = Diff(a.Image(), c.Image(), 0)
+	require.Equal(t, -1, count)
+}
@@ -107,6 +107,13 @@ type EnableState interface {
 	Enabled() bool
 }
 
+// FocusDrawer is implemented by widgets that show focus and editing
+// themselves. Containers draw no focus decoration around them while DrawsFocus
+// reports true.
+type FocusDrawer interface {
+	DrawsFocus() bool
+}
+
 // Scrollable declares support for manual scroll offset adjustments.
 // Widgets that do not implement this interface remain static in their context.
 type Scrollable interface {
@@ -151,6 +158,8 @@ type WidgetBase struct {
 	Width    uint16
 	Height   uint16
 	selected bool
+	// clean is false until the widget has been drawn, so new widgets start dirty.
+	clean bool
 }
 
 // NewWidgetBase constructs a WidgetBase with fixed width/height metadata.
@@ -163,16 +172,25 @@ func NewWidgetBase(width, height uint16) WidgetBase {
 
 func (c *WidgetBase) Parent() Widget          { return c.parent }
 func (c *WidgetBase) SetParent(widget Widget) { c.parent = widget }
-func (c *WidgetBase) SetSelected(s bool)      { c.selected = s }
 func (c *WidgetBase) Selected() bool          { return c.selected }
 func (c *WidgetBase) Size() (uint16, uint16)  { return c.Width, c.Height }
+func (c *WidgetBase) Invalidate()             { c.clean = false }
+func (c *WidgetBase) Dirty() bool             { return !c.clean }
+func (c *WidgetBase) ClearDirty()             { c.clean = true }
+
+// SetSelected updates the selection flag, marking the widget dirty on change.
+func (c *WidgetBase) SetSelected(s bool) {
+	if c.selected != s {
+		c.selected = s
+		c.clean = false
+	}
+}
+
 func (c *WidgetBase) Interact(cmd UserCommand) bool {
 	if cmd != ESC {
 		return false
 	}
 
-	if c.selected {
-		c.selected = false
-	}
+	c.SetSelected(false)
 	return true
 }
@@ -0,0 +1,234 @@
+package widget
+
+import (
+	"image/color"
+	"time"
+
+	ui "github.com/itohio/tinygui"
+	"github.com/itohio/tinygui/animation"
+	"github.com/itohio/tinygui/drawing"
+)
+
+// AnimatedIcon draws the frames of a sprite sheet in turn, e.g. spinners or
+// blinking warnings. Frames advance at a fixed rate or at the pace of an
+// animation.Animator, and the icon is only dirty when the frame changes, so
+// screens whose animations are paused or finished stay idle.
+type AnimatedIcon struct {
+	*Icon
+	sheet      *ui.SpriteSheet
+	clock      ui.Clock
+	interval   time.Duration
+	animator   animation.Animator
+	once       bool
+	playing    bool
+	start      time.Time
+	frame      int
+	background color.RGBA
+	onDone     func()
+
+	// Animator channel state: the frame index runs from from to to.
+	from, to, value [1]float32
+}
+
+// AnimatedIconOption customises an AnimatedIcon.
+type AnimatedIconOption func(*AnimatedIcon)
+
+// WithAnimatedIconFPS sets the frame rate; the default is 10 frames per
+// second.
+func WithAnimatedIconFPS(fps int) AnimatedIconOption {
+	return func(a *AnimatedIcon) {
+		if fps > 0 {
+			a.interval = time.Second / time.Duration(fps)
+		}
+	}
+}
+
+// WithAnimatedIconAnimator paces the frames with an animator instead of a
+// fixed rate. Its single channel runs from the first frame to past the last
+// one and the integer part of its value selects the frame, so easing curves
+// speed up or slow down the animation.
+func WithAnimatedIconAnimator(animator animation.Animator) AnimatedIconOption {
+	return func(a *AnimatedIcon) {
+		a.animator = animator
+	}
+}
+
+// WithAnimatedIconClock sets the clock driving the animation, e.g. a
+// ui.ManualClock in tests.
+func WithAnimatedIconClock(clock ui.Clock) AnimatedIconOption {
+	return func(a *AnimatedIcon) {
+		if clock != nil {
+			a.clock = clock
+		}
+	}
+}
+
+// WithAnimatedIconOnce plays the frames once and stops on the last one
+// instead of looping.
+func WithAnimatedIconOnce() AnimatedIconOption {
+	return func(a *AnimatedIcon) {
+		a.once = true
+	}
+}
+
+// WithAnimatedIconPaused constructs the icon paused on the first frame.
+func WithAnimatedIconPaused() AnimatedIconOption {
+	return func(a *AnimatedIcon) {
+		a.playing = false
+	}
+}
+
+// WithAnimatedIconDone registers a callback invoked when a one-shot animation
+// reaches its last frame.
+func WithAnimatedIconDone(fn func()) AnimatedIconOption {
+	return func(a *AnimatedIcon) {
+		a.onDone = fn
+	}
+}
+
+// WithAnimatedIconBackground fills the icon with c before each frame, for
+// frames with transparent pixels.
+func WithAnimatedIconBackground(c color.RGBA) AnimatedIconOption {
+	return func(a *AnimatedIcon) {
+		a.background = c
+	}
+}
+
+// NewAnimatedIcon constructs an icon playing the frames of sheet. A zero
+// width or height is taken from the frame size. The animation starts playing
+// unless WithAnimatedIconPaused is given.
+func NewAnimatedIcon(w, h uint16, sheet *ui.SpriteSheet, opts ...AnimatedIconOption) *AnimatedIcon {
+	a := &AnimatedIcon{
+		sheet:    sheet,
+		clock:    ui.SystemClock{},
+		interval: 100 * time.Millisecond,
+		playing:  true,
+	}
+	for _, opt := range opts {
+		opt(a)
+	}
+	a.Icon = NewImageIcon(w, h, a.current)
+	if a.playing {
+		a.playing = false
+		a.Play()
+	}
+	return a
+}
+
+// Draw fills the background, when one is set, and draws the current frame.
+func (a *AnimatedIcon) Draw(ctx ui.Context) {
+	if d := ctx.D(); d != nil && !isZeroColor(a.background) {
+		x, y := ctx.DisplayPos()
+		drawing.FillRect(d, x, y, int16(a.Width), int16(a.Height), a.background)
+	}
+	a.Icon.Draw(ctx)
+}
+
+// Sheet returns the sprite sheet played by the icon.
+func (a *AnimatedIcon) Sheet() *ui.SpriteSheet {
+	return a.sheet
+}
+
+// Frame returns the index of the current frame.
+func (a *AnimatedIcon) Frame() int {
+	a.advance()
+	return a.frame
+}
+
+// SetFrame shows frame i, continuing from there while playing.
+func (a *AnimatedIcon) SetFrame(i int) {
+	if n := a.frames(); n > 0 {
+		a.frame = min(max(i, 0), n-1)
+		a.rebase(a.clock.Now())
+	}
+}
+
+// Playing reports whether the frames are advancing.
+func (a *AnimatedIcon) Playing() bool {
+	a.advance()
+	return a.playing
+}
+
+// Play resumes the animation from the current frame, or restarts a one-shot
+// animation that has finished. Animators restart their full duration from the
+// current frame.
+func (a *AnimatedIcon) Play() {
+	if a.playing {
+		return
+	}
+	if a.once && a.frame >= a.frames()-1 {
+		a.frame = 0
+	}
+	a.playing = true
+	a.rebase(a.clock.Now())
+}
+
+// Pause holds the current frame.
+func (a *AnimatedIcon) Pause() {
+	a.advance()
+	a.playing = false
+}
+
+// Stop pauses the animation and rewinds it to the first frame.
+func (a *AnimatedIcon) Stop() {
+	a.playing = false
+	a.frame = 0
+}
+
+// current is the image provider of the embedded Icon.
+func (a *AnimatedIcon) current() ui.ImageSource {
+	if a.sheet == nil {
+		return nil
+	}
+	a.advance()
+	return a.sheet.Frame(a.frame)
+}
+
+func (a *AnimatedIcon) frames() int {
+	if a.sheet == nil {
+		return 0
+	}
+	return a.sheet.Len()
+}
+
+// rebase restarts the timing so that the current frame is shown at now.
+func (a *AnimatedIcon) rebase(now time.Time) {
+	a.start = now.Add(-time.Duration(a.frame) * a.interval)
+	if a.animator != nil {
+		a.from[0], a.to[0], a.value[0] = float32(a.frame), float32(a.frames()), float32(a.frame)
+		a.animator.Start(a.from[:], a.to[:], now.UnixMicro())
+	}
+}
+
+// advance moves to the frame due at the current time.
+func (a *AnimatedIcon) advance() {
+	n := a.frames()
+	if !a.playing || n == 0 {
+		return
+	}
+	now := a.clock.Now()
+	var step int
+	finished := false
+	if a.animator != nil {
+		finished = a.animator.Update(a.value[:], now.UnixMicro())
+		step = int(a.value[0])
+	} else {
+		step = int(now.Sub(a.start) / a.interval)
+		finished = step >= n-1
+	}
+	switch {
+	case a.once && finished:
+		a.frame = n - 1
+		a.playing = false
+		if a.onDone != nil {
+			a.onDone()
+		}
+	case a.animator != nil && finished:
+		a.frame = 0
+		a.rebase(now)
+	case a.animator != nil:
+		a.frame = min(max(step, 0), n-1)
+	default:
+		a.frame = step % n
+	}
+}
@@ -0,0 +1,76 @@
+package widget
+
+import (
+	"image/color"
+	"testing"
+	"time"
+
+	ui "github.com/itohio/tinygui"
+	"github.com/itohio/tinygui/animation"
+	"github.com/stretchr/testify/require"
+)
+
+// stripSheet returns a sheet of 1×1 frames in red, green and blue.
+func stripSheet() *ui.SpriteSheet {
+	return ui.NewSpriteSheet(ui.NewRGB565Image(3, 1, []uint16{0xF800, 0x07E0, 0x001F}), 1, 1)
+}
+
+func TestAnimatedIconAdvancesOnlyWhenFrameChanges(t *testing.T) {
+	clock := ui.NewManualClock(time.Unix(0, 0))
+	icon := NewAnimatedIcon(0, 0, stripSheet(), WithAnimatedIconClock(clock), WithAnimatedIconFPS(10))
+	require.Equal(t, uint16(1), icon.Width)
+
+	fb := ui.NewFramebuffer(1, 1, ui.PixelFormatRGB565)
+	ctx := ui.NewContext(fb, 1, 1, 0, 0)
+	ui.Redraw(&ctx, icon, color.RGBA{})
+	require.Equal(t, uint16(0xF800), ui.RGBATo565(fb.GetPixel(0, 0)))
+
+	clock.Advance(50 * time.Millisecond)
+	require.False(t, icon.Dirty(), "the frame is still due")
+	clock.Advance(60 * time.Millisecond)
+	require.True(t, icon.Dirty())
+	ui.Redraw(&ctx, icon, color.RGBA{})
+	require.Equal(t, uint16(0x07E0), ui.RGBATo565(fb.GetPixel(0, 0)))
+
+	icon.Pause()
+	clock.Advance(time.Second)
+	require.False(t, icon.Dirty(), "paused icons stay idle")
+	require.Equal(t, 1, icon.Frame())
+
+	icon.Play()
+	clock.Advance(200 * time.Millisecond)
+	require.Equal(t, 0, icon.Frame(), "looping wraps to the first frame")
+}
+
+func TestAnimatedIconOnce(t *testing.T) {
+	clock := ui.NewManualClock(time.Unix(0, 0))
+	done := 0
+	icon := NewAnimatedIcon(0, 0, stripSheet(), WithAnimatedIconClock(clock), WithAnimatedIconOnce(),
+		WithAnimatedIconDone(func() { done++ }))
+
+	clock.Advance(time.Second)
+	require.Equal(t, 2, icon.Frame())
+	require.False(t, icon.Playing())
+	require.Equal(t, 1, done)
+
+	icon.Play()
+	require.Equal(t, 0, icon.Frame(), "playing a finished animation restarts it")
+	icon.Stop()
+	clock.Advance(time.Second)
+	require.Equal(t, 0, icon.Frame())
+	require.Equal(t, 1, done)
+}
+
+func TestAnimatedIconFollowsAnimator(t *testing.T) {
+	clock := ui.NewManualClock(time.Unix(0, 0))
+	icon := NewAnimatedIcon(0, 0, stripSheet(), WithAnimatedIconClock(clock),
+		WithAnimatedIconAnimator(animation.NewEaseIn(int64(300*time.Millisecond/time.Microsecond))))
+
+	clock.Advance(150 * time.Millisecond)
+	require.Equal(t, 0, icon.Frame(), "easing in lingers on the first frame")
+	clock.Advance(100 * time.Millisecond)
+	require.Equal(t, 2, icon.Frame())
+	clock.Advance(100 * time.Millisecond)
+	require.Equal(t, 0, icon.Frame(), "finished animators restart when looping")
+	require.True(t, icon.Playing())
+}
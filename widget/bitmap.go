@@ -26,6 +26,7 @@ func (b *BitmapBase[T]) Pixels() []T {
 // SetPixels swaps the backing pixel buffer reference without additional allocation.
 func (b *BitmapBase[T]) SetPixels(pixels []T) {
 	b.pixels = pixels
+	b.Invalidate()
 }
 
 // Bitmap16 renders 16-bit (RGB565) bitmap data using DrawRGBBitmap.
@@ -95,3 +96,44 @@ func (b *Bitmap8) Draw(ctx ui.Context) {
 func (b *Bitmap8) SetPixels(pixels []uint8) {
 	b.BitmapBase.SetPixels(pixels)
 }
+
+// IndexedBitmap renders palette-indexed pixels packed at 1, 2, 4 or 8 bits
+// per pixel. Swapping the palette recolours the bitmap without touching the
+// pixel data.
+type IndexedBitmap struct {
+	*BitmapBase[uint8]
+	bpp     uint8
+	palette *ui.Palette
+}
+
+// NewIndexedBitmap constructs an indexed bitmap widget. Rows of pixels start
+// on byte boundaries (see ui.IndexedStride).
+func NewIndexedBitmap(width, height uint16, bpp uint8, pixels []uint8, palette *ui.Palette) *IndexedBitmap {
+	return &IndexedBitmap{
+		BitmapBase: NewBitmapBase(width, height, pixels),
+		bpp:        bpp,
+		palette:    palette,
+	}
+}
+
+// Draw expands the indices through the palette one row at a time.
+func (b *IndexedBitmap) Draw(ctx ui.Context) {
+	data := b.Pixels()
+	if len(data) == 0 || b.palette == nil {
+		return
+	}
+	w, h := b.Size()
+	x, y := ctx.DisplayPos()
+	_ = ui.DrawIndexed(ctx.D(), x, y, data, int16(w), int16(h), b.bpp, b.palette)
+}
+
+// Palette returns the palette used for drawing.
+func (b *IndexedBitmap) Palette() *ui.Palette {
+	return b.palette
+}
+
+// SetPalette swaps the palette, e.g. for theme or alarm colours.
+func (b *IndexedBitmap) SetPalette(palette *ui.Palette) {
+	b.palette = palette
+	b.Invalidate()
+}
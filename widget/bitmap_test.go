@@ -1,6 +1,7 @@
 package widget
 
 import (
+	"image/color"
 	"testing"
 
 	ui "github.com/itohio/tinygui"
@@ -22,3 +23,20 @@ func TestBitmap8Draw(t *testing.T) {
 	ctx := ui.NewContext(mockDisplay{}, 3, 3, 0, 0)
 	require.NotPanics(t, func() { bmp.Draw(&ctx) })
 }
+
+func TestIndexedBitmapSwapsPalette(t *testing.T) {
+	red := color.RGBA{0xFF, 0, 0, 0xFF}
+	blue := color.RGBA{0, 0, 0xFF, 0xFF}
+	bmp := NewIndexedBitmap(2, 1, 1, []uint8{0b01000000}, ui.NewPalette(color.RGBA{0, 0, 0, 0xFF}, red))
+
+	fb := ui.NewFramebuffer(2, 1, ui.PixelFormatRGB888)
+	ctx := ui.NewContext(fb, 2, 1, 0, 0)
+	bmp.Draw(&ctx)
+	require.Equal(t, red, fb.GetPixel(1, 0))
+
+	bmp.ClearDirty()
+	bmp.SetPalette(ui.NewPalette(color.RGBA{0, 0, 0, 0xFF}, blue))
+	require.True(t, bmp.Dirty())
+	bmp.Draw(&ctx)
+	require.Equal(t, blue, fb.GetPixel(1, 0))
+}
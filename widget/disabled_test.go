@@ -0,0 +1,115 @@
+package widget
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/png"
+	"testing"
+
+	ui "github.com/itohio/tinygui"
+	"github.com/stretchr/testify/require"
+	"tinygo.org/x/tinyfont"
+)
+
+// switchParent is a parent whose enabled state its children inherit.
+type switchParent struct {
+	ui.WidgetBase
+	enabled bool
+}
+
+func (p *switchParent) Draw(ui.Context) {}
+func (p *switchParent) Enabled() bool   { return p.enabled }
+
+func TestDisabledGaugeIsGreyed(t *testing.T) {
+	green := color.RGBA{0, 200, 0, 255}
+	value := float32(1)
+	g := NewInteractiveGauge[float32](8, 4, WithValue(&value), WithForeground[float32](green))
+	fb := ui.NewFramebuffer(8, 4, ui.PixelFormatRGB888)
+	ctx := ui.NewContext(fb, 8, 4, 0, 0)
+
+	g.Draw(&ctx)
+	require.Equal(t, green, fb.GetPixel(1, 1))
+
+	g.ClearDirty()
+	g.SetEnabled(false)
+	require.True(t, g.Dirty())
+	g.Draw(&ctx)
+	require.Equal(t, ui.Greyed(green), fb.GetPixel(1, 1))
+}
+
+func TestDisabledLabelUsesDisabledStyle(t *testing.T) {
+	grey := color.RGBA{100, 100, 100, 255}
+	theme := ui.NewTheme(ui.Style{Foreground: color.RGBA{255, 255, 255, 255}}).
+		Set(ui.RoleText, ui.StateDisabled, ui.Style{Foreground: grey})
+	value := 8
+	l := NewInteractiveLabel[int](20, 8, WithValue(&value), WithRange(0, 9))
+	fb := ui.NewFramebuffer(20, 8, ui.PixelFormatRGB888)
+	ctx := ui.NewContext(fb, 20, 8, 0, 0)
+	ctx.SetTheme(theme)
+
+	l.SetEnabled(false)
+	l.Draw(&ctx)
+	require.Contains(t, colours(fb), grey)
+}
+
+func TestDisabledIconIsDimmed(t *testing.T) {
+	red := color.RGBA{255, 0, 0, 255}
+	img := image.NewRGBA(image.Rect(0, 0, 2, 2))
+	for i := 0; i < 4; i++ {
+		img.SetRGBA(i%2, i/2, red)
+	}
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, img))
+
+	icon := NewInteractiveIcon(0, 0, []string{buf.String()})
+	fb := ui.NewFramebuffer(2, 2, ui.PixelFormatRGB888)
+	ctx := ui.NewContext(fb, 2, 2, 0, 0)
+	icon.Draw(&ctx)
+	require.Equal(t, red, fb.GetPixel(0, 0))
+
+	icon.SetEnabled(false)
+	icon.Draw(&ctx)
+	// Bitmaps pass through RGB565 on their way to the display.
+	require.Equal(t, ui.RGB565ToRGBA(ui.RGBATo565(ui.Greyed(red))), fb.GetPixel(0, 0))
+}
+
+func colours(fb *ui.Framebuffer) []color.RGBA {
+	w, h := fb.Size()
+	var out []color.RGBA
+	for y := int16(0); y < h; y++ {
+		for x := int16(0); x < w; x++ {
+			out = append(out, fb.GetPixel(x, y))
+		}
+	}
+	return out
+}
+
+func TestDisabledRichTextIsDimmed(t *testing.T) {
+	white := color.RGBA{255, 255, 255, 255}
+	value := "1"
+	r := NewRichText(0, 0, []Span{
+		BitmapSpan(4, 4, dropIcon()),
+		TextSpan(&tinyfont.TomThumb, func() string { return value }, white),
+	})
+	parent := &switchParent{WidgetBase: ui.NewWidgetBase(0, 0), enabled: true}
+	r.SetParent(parent)
+	w, h := r.Size()
+	fb := ui.NewFramebuffer(int16(w), int16(h), ui.PixelFormatRGB888)
+	ctx := ui.NewContext(fb, w, h, 0, 0)
+	blue := ui.RGB565ToRGBA(0x001F)
+	r.Draw(&ctx)
+	require.Contains(t, colours(fb), blue)
+	require.Contains(t, colours(fb), white)
+
+	parent.enabled = false
+	fb.FillScreen(color.RGBA{A: 255})
+	r.Draw(&ctx)
+	require.NotContains(t, colours(fb), blue)
+	require.Contains(t, colours(fb), ui.RGB565ToRGBA(ui.RGBATo565(ui.Greyed(blue))), "bitmaps are greyed")
+	require.Contains(t, colours(fb), ui.Greyed(white), "text is greyed")
+
+	dimmer := r.dimmer
+	r.Draw(&ctx)
+	require.Same(t, dimmer, r.dimmer, "the dimming filter is reused")
+}
@@ -4,6 +4,7 @@ import (
 	"image/color"
 
 	ui "github.com/itohio/tinygui"
+	"github.com/itohio/tinygui/drawing"
 )
 
 // Gauge renders a progress indicator whose orientation is inferred from its geometry.
@@ -14,6 +15,9 @@ type Gauge[T Number] struct {
 	Max        T
 	Foreground color.RGBA
 	Background color.RGBA
+	drawn      T
+	// owner is the widget embedding the gauge, whose state styles it.
+	owner ui.Widget
 }
 
 // NewGauge constructs a gauge with the provided geometry and colours.
@@ -52,6 +56,7 @@ func (g *Gauge[T]) Draw(ctx ui.Context) {
 	if g == nil || g.Value == nil {
 		return
 	}
+	g.drawn = *g.Value
 	if g.Height == 0 || g.Width >= g.Height {
 		g.drawHorizontal(ctx)
 		return
@@ -59,33 +64,34 @@ func (g *Gauge[T]) Draw(ctx ui.Context) {
 	g.drawVertical(ctx)
 }
 
+// colors resolves the fill and track colours. Zero colours come from the
+// theme; a zero track is not painted.
+func (g *Gauge[T]) colors(ctx ui.Context) (color.RGBA, color.RGBA) {
+	return gaugeColors(ctx, subject(g, g.owner), g.Foreground, g.Background)
+}
+
+// Dirty reports whether the gauge was invalidated or its bound value changed
+// since it was last drawn.
+func (g *Gauge[T]) Dirty() bool {
+	return g.WidgetBase.Dirty() || (g.Value != nil && *g.Value != g.drawn)
+}
+
 func (g *Gauge[T]) drawHorizontal(ctx ui.Context) {
 	d := ctx.D()
 	if d == nil {
 		return
 	}
+	fg, bg := g.colors(ctx)
 	val := clamp(g.Min, g.Max, *g.Value)
 	x, y := ctx.DisplayPos()
 	width := int16(g.Width)
 	height := int16(g.Height)
 
 	fill := position(g.Min, g.Max, val, width-2)
-	if !isZeroColor(g.Background) {
-		if fast, ok := d.(ui.RectangleDisplayer); ok {
-			_ = fast.FillRectangle(x, y, width, height, g.Background)
-		} else {
-			for dy := int16(0); dy < height; dy++ {
-				ui.HLine(d, x, y+dy, width, g.Background)
-			}
-		}
-	}
-	if fast, ok := d.(ui.RectangleDisplayer); ok {
-		_ = fast.FillRectangle(x+1, y+1, fill, height-2, g.Foreground)
-	} else {
-		for dy := int16(0); dy < height-2; dy++ {
-			ui.HLine(d, x+1, y+1+dy, fill, g.Foreground)
-		}
+	if !isZeroColor(bg) {
+		drawing.FillRect(d, x, y, width, height, bg)
 	}
+	drawing.FillRect(d, x+1, y+1, fill, height-2, fg)
 }
 
 func (g *Gauge[T]) drawVertical(ctx ui.Context) {
@@ -93,28 +99,17 @@ func (g *Gauge[T]) drawVertical(ctx ui.Context) {
 	if d == nil {
 		return
 	}
+	fg, bg := g.colors(ctx)
 	val := clamp(g.Min, g.Max, *g.Value)
 	x, y := ctx.DisplayPos()
 	width := int16(g.Width)
 	height := int16(g.Height)
 
 	fill := position(g.Min, g.Max, val, height-2)
-	if !isZeroColor(g.Background) {
-		if fast, ok := d.(ui.RectangleDisplayer); ok {
-			_ = fast.FillRectangle(x, y, width, height, g.Background)
-		} else {
-			for dx := int16(0); dx < width; dx++ {
-				ui.VLine(d, x+dx, y, height, g.Background)
-			}
-		}
-	}
-	if fast, ok := d.(ui.RectangleDisplayer); ok {
-		_ = fast.FillRectangle(x+1, y+height-1-fill, width-2, fill, g.Foreground)
-	} else {
-		for dx := int16(0); dx < width-2; dx++ {
-			ui.VLine(d, x+1+dx, y+height-1-fill, fill, g.Foreground)
-		}
+	if !isZeroColor(bg) {
+		drawing.FillRect(d, x, y, width, height, bg)
 	}
+	drawing.FillRect(d, x+1, y+height-1-fill, width-2, fill, fg)
 }
 
 // MultiGauge renders a gauge with multiple values represented as coloured segments.
@@ -126,6 +121,8 @@ type MultiGauge[T Number] struct {
 	Colors     []color.RGBA
 	Background color.RGBA
 	Foreground color.RGBA
+	drawn      []T
+	owner      ui.Widget
 }
 
 // NewMultiGauge constructs a multivalue gauge.
@@ -163,6 +160,7 @@ func (g *MultiGauge[T]) Draw(ctx ui.Context) {
 	if g == nil || g.Values == nil || *g.Values == nil {
 		return
 	}
+	g.drawn = append(g.drawn[:0], *g.Values...)
 	if g.Height == 0 || g.Width >= g.Height {
 		g.drawHorizontal(ctx)
 		return
@@ -170,23 +168,52 @@ func (g *MultiGauge[T]) Draw(ctx ui.Context) {
 	g.drawVertical(ctx)
 }
 
+// Dirty reports whether the gauge was invalidated or any bound value changed
+// since it was last drawn.
+func (g *MultiGauge[T]) Dirty() bool {
+	if g.WidgetBase.Dirty() {
+		return true
+	}
+	if g.Values == nil {
+		return false
+	}
+	values := *g.Values
+	if len(values) != len(g.drawn) {
+		return true
+	}
+	for i, v := range values {
+		if v != g.drawn[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// colors resolves the default segment and track colours from the theme when
+// they are zero.
+func (g *MultiGauge[T]) colors(ctx ui.Context) (color.RGBA, color.RGBA) {
+	return gaugeColors(ctx, subject(g, g.owner), g.Foreground, g.Background)
+}
+
+// gaugeColors resolves fill and track colours of a gauge styled by w. Explicit
+// colours are greyed while w is disabled.
+func gaugeColors(ctx ui.Context, w ui.Widget, fg, bg color.RGBA) (color.RGBA, color.RGBA) {
+	style := ui.StyleOf(ctx, w, ui.RoleGauge)
+	return colorFor(w, fg, style.Foreground), colorFor(w, bg, style.Background)
+}
+
 func (g *MultiGauge[T]) drawHorizontal(ctx ui.Context) {
 	d := ctx.D()
 	if d == nil {
 		return
 	}
+	fg, bg := g.colors(ctx)
 	x, y := ctx.DisplayPos()
 	width := int16(g.Width)
 	height := int16(g.Height)
 
-	if !isZeroColor(g.Background) {
-		if fast, ok := d.(ui.RectangleDisplayer); ok {
-			_ = fast.FillRectangle(x, y, width, height, g.Background)
-		} else {
-			for dy := int16(0); dy < height; dy++ {
-				ui.HLine(d, x, y+dy, width, g.Background)
-			}
-		}
+	if !isZeroColor(bg) {
+		drawing.FillRect(d, x, y, width, height, bg)
 	}
 
 	vals := *g.Values
@@ -197,17 +224,11 @@ func (g *MultiGauge[T]) drawHorizontal(ctx ui.Context) {
 		if segWidth <= 0 {
 			continue
 		}
-		segColor := g.Foreground
+		segColor := fg
 		if i < len(g.Colors) {
-			segColor = g.Colors[i]
-		}
-		if fast, ok := d.(ui.RectangleDisplayer); ok {
-			_ = fast.FillRectangle(x+1+cumulative, y+1, segWidth, height-2, segColor)
-		} else {
-			for dy := int16(0); dy < height-2; dy++ {
-				ui.HLine(d, x+1+cumulative, y+1+dy, segWidth, segColor)
-			}
+			segColor = colorFor(subject(g, g.owner), g.Colors[i], fg)
 		}
+		drawing.FillRect(d, x+1+cumulative, y+1, segWidth, height-2, segColor)
 		cumulative += segWidth
 		if cumulative >= width-2 {
 			break
@@ -220,18 +241,13 @@ func (g *MultiGauge[T]) drawVertical(ctx ui.Context) {
 	if d == nil {
 		return
 	}
+	fg, bg := g.colors(ctx)
 	x, y := ctx.DisplayPos()
 	width := int16(g.Width)
 	height := int16(g.Height)
 
-	if !isZeroColor(g.Background) {
-		if fast, ok := d.(ui.RectangleDisplayer); ok {
-			_ = fast.FillRectangle(x, y, width, height, g.Background)
-		} else {
-			for dx := int16(0); dx < width; dx++ {
-				ui.VLine(d, x+dx, y, height, g.Background)
-			}
-		}
+	if !isZeroColor(bg) {
+		drawing.FillRect(d, x, y, width, height, bg)
 	}
 
 	vals := *g.Values
@@ -242,17 +258,11 @@ func (g *MultiGauge[T]) drawVertical(ctx ui.Context) {
 		if segHeight <= 0 {
 			continue
 		}
-		segColor := g.Foreground
+		segColor := fg
 		if i < len(g.Colors) {
-			segColor = g.Colors[i]
-		}
-		if fast, ok := d.(ui.RectangleDisplayer); ok {
-			_ = fast.FillRectangle(x+1, y+height-1-cumulative-segHeight, width-2, segHeight, segColor)
-		} else {
-			for dx := int16(0); dx < width-2; dx++ {
-				ui.VLine(d, x+1+dx, y+height-1-cumulative-segHeight, segHeight, segColor)
-			}
+			segColor = colorFor(subject(g, g.owner), g.Colors[i], fg)
 		}
+		drawing.FillRect(d, x+1, y+height-1-cumulative-segHeight, width-2, segHeight, segColor)
 		cumulative += segHeight
 		if cumulative >= height-2 {
 			break
@@ -25,3 +25,16 @@ func TestVerticalGaugeClampsValue(t *testing.T) {
 	ctx := ui.NewContext(nil, 8, 40, 0, 0)
 	require.NotPanics(t, func() { g.Draw(&ctx) })
 }
+
+func TestGaugeDirtyTracksValue(t *testing.T) {
+	value := float32(0.5)
+	g := NewGauge[float32](20, 4, &value, 0, 1, color.RGBA{255, 255, 255, 255}, color.RGBA{})
+	fb := ui.NewFramebuffer(20, 4, ui.PixelFormatRGB565)
+	ctx := ui.NewContext(fb, 20, 4, 0, 0)
+	g.Draw(&ctx)
+	g.ClearDirty()
+	require.False(t, g.Dirty())
+
+	value = 0.75
+	require.True(t, g.Dirty())
+}
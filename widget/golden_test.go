@@ -0,0 +1,61 @@
+package widget
+
+import (
+	"image/color"
+	"testing"
+
+	"github.com/itohio/tinygui/text"
+	"github.com/itohio/tinygui/uitest"
+	"github.com/stretchr/testify/require"
+	"tinygo.org/x/tinyfont"
+)
+
+func TestVolumeGaugeGolden(t *testing.T) {
+	value := uint16(60)
+	g := NewVolumeGauge[uint16](60, 10, &value, 0, 100, 6, color.RGBA{255, 255, 255, 255}, color.RGBA{0, 0, 40, 255})
+	uitest.Snapshot(t, "volume_gauge", g)
+}
+
+func TestGaugeGolden(t *testing.T) {
+	value := float32(0.3)
+	horizontal := NewGauge[float32](40, 8, &value, 0, 1, color.RGBA{0, 200, 0, 255}, color.RGBA{60, 60, 60, 255})
+	uitest.Snapshot(t, "gauge_horizontal", horizontal)
+
+	vertical := NewGauge[float32](8, 30, &value, 0, 1, color.RGBA{200, 0, 0, 255}, color.RGBA{60, 60, 60, 255})
+	uitest.Snapshot(t, "gauge_vertical", vertical)
+}
+
+func TestMultilineGolden(t *testing.T) {
+	log := NewLog(48, 8, 3, &tinyfont.TomThumb, color.RGBA{255, 255, 0, 255})
+	for _, line := range []string{"boot", "pump on", "t=21.5", "pump off"} {
+		log.Append(line)
+	}
+	uitest.Snapshot(t, "log_newest_bottom", log)
+
+	top := NewMultilineLabel(48, 8, 3, WithMultilineOrder(MultilineNewestOnTop))
+	top.SetLines([]string{"one", "two", "three", "four"})
+	uitest.Snapshot(t, "multiline_newest_top", top)
+
+	wrapped := NewLog(48, 8, 4, &tinyfont.TomThumb, color.RGBA{0, 255, 255, 255})
+	wrapped.Append("boot")
+	wrapped.Append("pump 2 stalled, retrying in 5s")
+	require.Equal(t, []string{"boot", "pump 2", "stalled,", "retrying in 5s"}, wrapped.Lines())
+	uitest.Snapshot(t, "log_wrapped", wrapped)
+}
+
+func TestLabelGolden(t *testing.T) {
+	label := NewLabel(40, 8, &tinyfont.TomThumb, func() string { return "Pump 42%" }, color.RGBA{255, 255, 255, 255})
+	uitest.Snapshot(t, "label", label)
+
+	centred := NewLabel(40, 10, &tinyfont.TomThumb, func() string { return "OK" }, color.RGBA{255, 255, 255, 255},
+		WithLabelAlign(text.AlignCenter, text.AlignMiddle))
+	uitest.Snapshot(t, "label_centred", centred)
+
+	clipped := NewLabel(40, 8, &tinyfont.TomThumb, func() string { return "Temperature 21.5C" }, color.RGBA{255, 255, 255, 255},
+		WithLabelEllipsis("..."))
+	uitest.Snapshot(t, "label_ellipsis", clipped)
+
+	wrapped := NewLabel(40, 14, &tinyfont.TomThumb, func() string { return "Filter needs cleaning" }, color.RGBA{255, 255, 255, 255},
+		WithLabelWrap(), WithLabelAlign(text.AlignRight, text.AlignTop))
+	uitest.Snapshot(t, "label_wrapped", wrapped)
+}
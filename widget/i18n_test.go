@@ -0,0 +1,53 @@
+package widget
+
+import (
+	"image/color"
+	"testing"
+
+	ui "github.com/itohio/tinygui"
+	"github.com/itohio/tinygui/i18n"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	strPump i18n.StringID = iota
+	strOff
+	strOn
+)
+
+func TestTranslatedWidgetsFollowLocale(t *testing.T) {
+	t.Cleanup(i18n.Reset)
+	i18n.Register(
+		&i18n.Locale{Tag: "en", Strings: []string{"Pump", "Off", "On"}},
+		&i18n.Locale{Tag: "lt", Strings: []string{"Siurblys", "Išjungta", "Įjungta"}},
+	)
+	require.True(t, i18n.SetLocale("en"))
+
+	label := NewLabelID(40, 8, nil, strPump, color.RGBA{})
+	choice := NewInteractiveLabelChoiceID(0, 0, []i18n.StringID{strOff, strOn})
+	require.Equal(t, "Pump", label.Text())
+	require.Equal(t, "Off", choice.Text())
+	fb := ui.NewFramebuffer(40, 8, ui.PixelFormatRGB565)
+	ctx := ui.NewContext(fb, 40, 8, 0, 0)
+	label.Draw(&ctx)
+	choice.Draw(&ctx)
+	ui.MarkClean(label)
+	ui.MarkClean(choice)
+	require.False(t, label.Dirty())
+	require.False(t, choice.Dirty())
+
+	w, _ := choice.Size()
+	font := defaultFont(nil, ui.RoleText)
+	var widest uint16
+	for _, s := range []string{"Off", "On", "Išjungta", "Įjungta"} {
+		sw, _ := textSize(font, s)
+		widest = max(widest, sw)
+	}
+	require.Equal(t, widest, w, "choice fits the longest item of every locale")
+
+	require.True(t, i18n.SetLocale("lt"))
+	require.True(t, label.Dirty())
+	require.True(t, choice.Dirty())
+	require.Equal(t, "Siurblys", label.Text())
+	require.Equal(t, "Išjungta", choice.Text())
+}
@@ -4,44 +4,140 @@ import (
 	ui "github.com/itohio/tinygui"
 )
 
-// Icon renders a PNG string using TinyGUI's DrawPng helper.
+// Icon renders an image from any ui.ImageSource: PNG, raw RGB565, indexed,
+// mask or RLE data.
 type Icon struct {
 	ui.WidgetBase
-	image func() string
+	source func() ui.ImageSource
+	drawn  ui.ImageSource
+	// png and drawnPNG serve PNG providers, compared as strings so that
+	// dirty checks do not box a PNGImage on every render pass.
+	png      func() string
+	drawnPNG string
+	hint     sizeHint
+	// owner is the widget embedding the icon, whose enabled state dims it.
+	owner  ui.Widget
+	dimmer *ui.FilterDisplayer
 }
 
+// Draw renders the image, greyed through a colour filter while the icon (or
+// the widget embedding it) is disabled.
 func (w *Icon) Draw(ctx ui.Context) {
 	x, y := ctx.DisplayPos()
-	if bmp, ok := ctx.D().(ui.BitmapDisplayer); ok {
-		ui.DrawPng(bmp, x, y, w.Image())
+	if w.png != nil {
+		w.drawnPNG = w.png()
+	} else {
+		w.drawn = w.source()
 	}
+	d := ctx.D()
+	if d == nil || (w.png == nil && w.drawn == nil) {
+		return
+	}
+	if !ui.Enabled(subject(w, w.owner)) {
+		d = dimmed(d, ui.Rect{X: x, Y: y, W: int16(w.Width), H: int16(w.Height)}, &w.dimmer)
+	}
+	if w.png != nil {
+		_ = ui.DrawImage(d, x, y, ui.PNGImage(w.drawnPNG))
+		return
+	}
+	_ = ui.DrawImage(d, x, y, w.drawn)
+}
+
+// Dirty reports whether the icon was invalidated or its image changed since it
+// was last drawn.
+func (w *Icon) Dirty() bool {
+	if w.png != nil {
+		return w.WidgetBase.Dirty() || w.png() != w.drawnPNG
+	}
+	return w.WidgetBase.Dirty() || w.source() != w.drawn
 }
 
 // SetImage updates the PNG payload rendered by the icon.
 func (w *Icon) SetImage(image string) {
-	w.image = func() string { return image }
+	w.png = func() string { return image }
 }
 
-// Image returns the underlying image payload.
+// Image returns the PNG payload of the image, or "" for other sources.
 func (w *Icon) Image() string {
-	return w.image()
+	if w.png != nil {
+		return w.png()
+	}
+	png, _ := w.source().(ui.PNGImage)
+	return string(png)
 }
 
-// NewIcon constructs an icon of fixed size.
+// SetSource updates the image rendered by the icon.
+func (w *Icon) SetSource(src ui.ImageSource) {
+	w.source = func() ui.ImageSource { return src }
+	w.png = nil
+}
+
+// Source returns the image rendered by the icon.
+func (w *Icon) Source() ui.ImageSource {
+	if w.png != nil {
+		return ui.PNGImage(w.png())
+	}
+	return w.source()
+}
+
+// NewIcon constructs an icon of fixed size drawing PNG data. A zero width or
+// height is read from the PNG header of the initial image.
 func NewIcon(w, h uint16, image func() string) *Icon {
 	if image == nil {
 		image = func() string { return "" }
 	}
-	return &Icon{
+	icon := &Icon{
+		WidgetBase: ui.NewWidgetBase(w, h),
+		png:        image,
+		hint:       newSizeHint(w, h),
+	}
+	icon.Width, icon.Height = icon.PreferredSize()
+	return icon
+}
+
+// NewImageIcon constructs an icon of fixed size drawing the image returned by
+// source. A zero width or height is taken from the initial image.
+func NewImageIcon(w, h uint16, source func() ui.ImageSource) *Icon {
+	if source == nil {
+		source = func() ui.ImageSource { return nil }
+	}
+	icon := &Icon{
 		WidgetBase: ui.NewWidgetBase(w, h),
-		image:      image,
+		source:     source,
+		hint:       newSizeHint(w, h),
+	}
+	icon.Width, icon.Height = icon.PreferredSize()
+	return icon
+}
+
+// MinSize returns the dimensions of the current image.
+func (w *Icon) MinSize() (uint16, uint16) {
+	if w.png != nil {
+		iw, ih, _ := ui.PngSize(w.png())
+		return iw, ih
 	}
+	return imageSize(w.source())
 }
 
-// SetTextProvider swaps the callback used to fetch text during drawing.
+// PreferredSize returns the image dimensions for automatic dimensions and the
+// configured size otherwise.
+func (w *Icon) PreferredSize() (uint16, uint16) {
+	iw, ih := w.MinSize()
+	return w.hint.resolve(&w.WidgetBase, iw, ih)
+}
+
+// SetImageProvider swaps the callback used to fetch PNG data during drawing.
 func (w *Icon) SetImageProvider(fn func() string) {
 	if fn != nil {
-		w.image = fn
+		w.png = fn
+	}
+}
+
+// SetSourceProvider swaps the callback used to fetch the image during drawing.
+func (w *Icon) SetSourceProvider(fn func() ui.ImageSource) {
+	if fn != nil {
+		w.source = fn
+		w.png = nil
 	}
 }
 
@@ -4,6 +4,7 @@ import (
 	"image/color"
 
 	ui "github.com/itohio/tinygui"
+	"github.com/itohio/tinygui/i18n"
 	"tinygo.org/x/tinyfont"
 )
 
@@ -12,6 +13,8 @@ type InteractiveLabelChoice struct {
 	Label
 	selector *InteractiveSelector[string]
 	onChange func(int, string)
+	// ids holds the string IDs of choices built by NewInteractiveLabelChoiceID.
+	ids []i18n.StringID
 }
 
 // InteractiveLabelChoiceOption configures label choice construction.
@@ -20,6 +23,7 @@ type InteractiveLabelChoiceOption func(*labelChoiceConfig)
 type labelChoiceConfig struct {
 	font         tinyfont.Fonter
 	color        color.RGBA
+	background   color.RGBA
 	selectorOpts []InteractiveSelectorOption[string]
 	onChange     func(int, string)
 }
@@ -59,35 +63,87 @@ func WithLabelChoiceColor(col color.RGBA) InteractiveLabelChoiceOption {
 	}
 }
 
+// WithLabelChoiceBackground sets the colour cleared behind the active item
+// when it changes (see WithLabelBackground).
+func WithLabelChoiceBackground(col color.RGBA) InteractiveLabelChoiceOption {
+	return func(cfg *labelChoiceConfig) {
+		cfg.background = col
+	}
+}
+
 // NewInteractiveLabelChoice constructs a label-backed selector that cycles through the provided items.
+// A zero width or height fits the longest item.
 func NewInteractiveLabelChoice(width, height uint16, items []string, opts ...InteractiveLabelChoiceOption) *InteractiveLabelChoice {
 	cfg := labelChoiceConfig{}
 	for _, opt := range opts {
 		opt(&cfg)
 	}
-	if cfg.font == nil {
-		cfg.font = &tinyfont.TomThumb
-	}
 
 	selectorOpts := cfg.selectorOpts
 	choice := &InteractiveLabelChoice{}
 	selectorOpts = append(selectorOpts, WithSelectorChange[string](func(i int, value string) {
 		if cfg.onChange != nil {
-			cfg.onChange(i, value)
+			cfg.onChange(i, choice.itemText())
 		}
-		choice.Label.SetText(value)
 	}))
 
 	selector := NewInteractiveSelector(items, selectorOpts...)
 	choice.selector = selector
 
-	value, _ := selector.Current()
-	choice.Label = *NewLabel(width, height, cfg.font, nil, cfg.color)
-	choice.Label.SetText(value)
+	choice.Label = *NewLabel(width, height, cfg.font, choice.itemText, cfg.color, WithLabelBackground(cfg.background))
+	choice.Label.owner = choice
+	choice.Width, choice.Height = choice.PreferredSize()
 
 	return choice
 }
 
+// NewInteractiveLabelChoiceID constructs a label choice over translatable
+// strings. The selected item follows the active i18n locale, and automatic
+// dimensions fit the longest item of every registered locale.
+func NewInteractiveLabelChoiceID(width, height uint16, ids []i18n.StringID, opts ...InteractiveLabelChoiceOption) *InteractiveLabelChoice {
+	items := make([]string, len(ids))
+	for i, id := range ids {
+		items[i] = i18n.T(id)
+	}
+	choice := NewInteractiveLabelChoice(width, height, items, opts...)
+	choice.ids = ids
+	choice.Width, choice.Height = choice.PreferredSize()
+	return choice
+}
+
+// MinSize returns the smallest label size among the options.
+func (c *InteractiveLabelChoice) MinSize() (uint16, uint16) {
+	return c.measure(true)
+}
+
+// PreferredSize sizes automatic dimensions to fit the longest option.
+func (c *InteractiveLabelChoice) PreferredSize() (uint16, uint16) {
+	w, h := c.measure(false)
+	return c.hint.resolve(&c.WidgetBase, w, h)
+}
+
+func (c *InteractiveLabelChoice) measure(minimum bool) (uint16, uint16) {
+	var w, h uint16
+	fit := func(item string) {
+		iw, ih := textSize(c.Font(), item)
+		if minimum && c.style.Ellipsis != "" {
+			ew, _ := textSize(c.Font(), c.style.Ellipsis)
+			iw = min(iw, ew)
+		}
+		w = max(w, iw)
+		h = max(h, ih)
+	}
+	for _, item := range c.selector.Items() {
+		fit(item)
+	}
+	for _, locale := range i18n.Locales() {
+		for _, id := range c.ids {
+			fit(locale.Text(id))
+		}
+	}
+	return w, h
+}
+
 // Selector exposes the underlying selector for advanced coordination (commit/cancel flows).
 func (c *InteractiveLabelChoice) Selector() *InteractiveSelector[string] {
 	return c.selector
@@ -98,12 +154,13 @@ func (c *InteractiveLabelChoice) Enabled() bool {
 	return c.selector.Enabled()
 }
 
-// SetEnabled toggles interaction ability.
+// SetEnabled toggles interaction ability and redraws the label greyed or normal.
 func (c *InteractiveLabelChoice) SetEnabled(v bool) {
 	c.selector.SetEnabled(v)
 	if !v {
 		c.Label.SetSelected(false)
 	}
+	c.Invalidate()
 }
 
 // Interact processes navigation commands and updates the label text accordingly.
@@ -113,8 +170,6 @@ func (c *InteractiveLabelChoice) Interact(cmd ui.UserCommand) bool {
 		if cmd == ui.ESC || cmd == ui.BACK {
 			return c.Label.WidgetBase.Interact(cmd)
 		}
-		value, _ := c.selector.Current()
-		c.Label.SetText(value)
 		return true
 	}
 	return c.Label.WidgetBase.Interact(cmd)
@@ -125,6 +180,16 @@ func (c *InteractiveLabelChoice) Draw(ctx ui.Context) {
 	c.Label.Draw(ctx)
 }
 
+// itemText returns the text of the selected item, translated when the choice
+// was built from string IDs.
+func (c *InteractiveLabelChoice) itemText() string {
+	if i := c.selector.Index(); i >= 0 && i < len(c.ids) {
+		return i18n.T(c.ids[i])
+	}
+	value, _ := c.selector.Current()
+	return value
+}
+
 // currentText returns the selected text for testing or diagnostics.
 func (c *InteractiveLabelChoice) currentText() string {
 	value, _ := c.selector.Current()
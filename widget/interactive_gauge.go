@@ -33,7 +33,8 @@ func NewInteractiveGauge[T Number](width, height uint16, opts ...InteractiveOpti
 		stepLarge: 1,
 		enabled:   true,
 	}
-	g.Gauge = NewGauge(width, height, &g.pending, g.min, g.max, color.RGBA{255, 255, 255, 255}, color.RGBA{})
+	g.Gauge = NewGauge(width, height, &g.pending, g.min, g.max, color.RGBA{}, color.RGBA{})
+	g.Gauge.owner = g
 
 	for _, opt := range opts {
 		opt.applyToGauge(g)
@@ -94,14 +95,18 @@ func (g *InteractiveGauge[T]) load() {
 // Enabled reports whether the gauge accepts commands.
 func (g *InteractiveGauge[T]) Enabled() bool { return g.enabled }
 
-// SetEnabled toggles interaction ability.
+// SetEnabled toggles interaction ability; fills are desaturated while disabled.
 func (g *InteractiveGauge[T]) SetEnabled(v bool) {
 	g.enabled = v
 	if !v {
 		g.SetSelected(false)
 	}
+	g.Invalidate()
 }
 
+// Editing reports whether a value change is pending confirmation.
+func (g *InteractiveGauge[T]) Editing() bool { return g.selecting }
+
 // Interact handles user commands.
 func (g *InteractiveGauge[T]) Interact(cmd ui.UserCommand) bool {
 	if !g.enabled {
@@ -225,7 +230,8 @@ func NewInteractiveMultiGauge[T Number](width, height uint16, opts ...Interactiv
 		active:    0,
 	}
 	g.pendingView = g.pending[:0]
-	g.MultiGauge = NewMultiGauge(width, height, g.min, g.max, &g.pendingView, nil, color.RGBA{}, color.RGBA{255, 255, 255, 255})
+	g.MultiGauge = NewMultiGauge(width, height, g.min, g.max, &g.pendingView, nil, color.RGBA{}, color.RGBA{})
+	g.MultiGauge.owner = g
 
 	for _, opt := range opts {
 		opt.applyToMulti(g)
@@ -282,12 +288,13 @@ func (g *InteractiveMultiGauge[T]) load() {
 // Enabled reports whether the gauge accepts commands.
 func (g *InteractiveMultiGauge[T]) Enabled() bool { return g.enabled }
 
-// SetEnabled toggles interaction ability.
+// SetEnabled toggles interaction ability; fills are desaturated while disabled.
 func (g *InteractiveMultiGauge[T]) SetEnabled(v bool) {
 	g.enabled = v
 	if !v {
 		g.SetSelected(false)
 	}
+	g.Invalidate()
 }
 
 // Interact handles user commands across segments.
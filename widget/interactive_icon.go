@@ -4,11 +4,11 @@ import (
 	ui "github.com/itohio/tinygui"
 )
 
-// InteractiveIcon renders one of several PNG icons and swaps the active image on user commands.
+// InteractiveIcon renders one of several images and swaps the active image on user commands.
 type InteractiveIcon struct {
 	*Icon
 
-	icons    []string
+	icons    []ui.ImageSource
 	index    int
 	external *int
 	onChange func(int)
@@ -42,32 +42,53 @@ func WithIconDisabled() InteractiveIconOption {
 	}
 }
 
-// NewInteractiveIcon constructs an icon selector that cycles through the provided icons.
+// NewInteractiveIcon constructs an icon selector that cycles through the provided PNG icons.
+// A zero width or height fits the largest icon.
 func NewInteractiveIcon(width, height uint16, icons []string, opts ...InteractiveIconOption) *InteractiveIcon {
-	icon := NewIcon(width, height, func() string { return "" })
+	return NewInteractiveImageIcon(width, height, pngSources(icons), opts...)
+}
+
+// NewInteractiveImageIcon constructs an icon selector cycling through images of any source.
+// A zero width or height fits the largest image.
+func NewInteractiveImageIcon(width, height uint16, icons []ui.ImageSource, opts ...InteractiveIconOption) *InteractiveIcon {
+	icon := NewImageIcon(width, height, nil)
 	i := &InteractiveIcon{
 		Icon:    icon,
 		icons:   icons,
 		enabled: true,
 	}
+	icon.owner = i
 	for _, opt := range opts {
 		opt(i)
 	}
 	i.load(false)
+	i.Width, i.Height = i.PreferredSize()
 	return i
 }
 
+// MinSize returns the dimensions of the largest icon.
+func (i *InteractiveIcon) MinSize() (uint16, uint16) {
+	return imageSize(i.icons...)
+}
+
+// PreferredSize sizes automatic dimensions to fit every icon.
+func (i *InteractiveIcon) PreferredSize() (uint16, uint16) {
+	w, h := i.MinSize()
+	return i.hint.resolve(&i.WidgetBase, w, h)
+}
+
 // Enabled reports whether the icon responds to user commands.
 func (i *InteractiveIcon) Enabled() bool {
 	return i.enabled
 }
 
-// SetEnabled toggles user interaction.
+// SetEnabled toggles user interaction; a disabled icon is drawn dimmed.
 func (i *InteractiveIcon) SetEnabled(v bool) {
 	i.enabled = v
 	if !v {
 		i.Icon.SetSelected(false)
 	}
+	i.Invalidate()
 }
 
 // Interact handles rotation commands. Up/Next/Right advance, Down/Prev/Left go backwards.
@@ -113,7 +134,7 @@ func (i *InteractiveIcon) shift(delta int) {
 
 func (i *InteractiveIcon) load(notify bool) {
 	if len(i.icons) == 0 {
-		i.SetImage("")
+		i.SetSource(nil)
 		return
 	}
 	index := i.currentIndex()
@@ -129,7 +150,7 @@ func (i *InteractiveIcon) currentIndex() int {
 
 func (i *InteractiveIcon) applyIndex(index int, notify bool) {
 	if len(i.icons) == 0 {
-		i.SetImage("")
+		i.SetSource(nil)
 		return
 	}
 	if index < 0 {
@@ -142,7 +163,7 @@ func (i *InteractiveIcon) applyIndex(index int, notify bool) {
 	if i.external != nil {
 		*i.external = index
 	}
-	i.SetImage(i.icons[index])
+	i.SetSource(i.icons[index])
 	if notify && i.onChange != nil {
 		i.onChange(index)
 	}
@@ -5,28 +5,39 @@ import ui "github.com/itohio/tinygui"
 // InteractiveIconChoice renders selectable image data via an Icon widget while using InteractiveSelector for navigation.
 type InteractiveIconChoice struct {
 	*Icon
-	selector *InteractiveSelector[string]
-	onChange func(int, string)
-	current  string
+	selector *InteractiveSelector[ui.ImageSource]
+	current  ui.ImageSource
 }
 
 // InteractiveIconChoiceOption configures icon choice construction.
 type InteractiveIconChoiceOption func(*iconChoiceConfig)
 
 type iconChoiceConfig struct {
-	selectorOpts []InteractiveSelectorOption[string]
-	onChange     func(int, string)
+	selectorOpts []InteractiveSelectorOption[ui.ImageSource]
+	onChange     func(int, ui.ImageSource)
 }
 
 // WithIconChoiceIndex wires an external index pointer for the icon selector.
 func WithIconChoiceIndex(ptr *int) InteractiveIconChoiceOption {
 	return func(cfg *iconChoiceConfig) {
-		cfg.selectorOpts = append(cfg.selectorOpts, WithSelectorIndex[string](ptr))
+		cfg.selectorOpts = append(cfg.selectorOpts, WithSelectorIndex[ui.ImageSource](ptr))
 	}
 }
 
 // WithIconChoiceChange registers a callback invoked whenever the icon changes.
+// It receives the PNG payload of the new image, or "" for other sources.
 func WithIconChoiceChange(fn func(int, string)) InteractiveIconChoiceOption {
+	return func(cfg *iconChoiceConfig) {
+		cfg.onChange = func(i int, src ui.ImageSource) {
+			png, _ := src.(ui.PNGImage)
+			fn(i, string(png))
+		}
+	}
+}
+
+// WithImageChoiceChange registers a callback receiving the new image whenever
+// the icon changes.
+func WithImageChoiceChange(fn func(int, ui.ImageSource)) InteractiveIconChoiceOption {
 	return func(cfg *iconChoiceConfig) {
 		cfg.onChange = fn
 	}
@@ -35,12 +46,19 @@ func WithIconChoiceChange(fn func(int, string)) InteractiveIconChoiceOption {
 // WithIconChoiceDisabled initialises the icon choice in a disabled state.
 func WithIconChoiceDisabled() InteractiveIconChoiceOption {
 	return func(cfg *iconChoiceConfig) {
-		cfg.selectorOpts = append(cfg.selectorOpts, WithSelectorDisabled[string]())
+		cfg.selectorOpts = append(cfg.selectorOpts, WithSelectorDisabled[ui.ImageSource]())
 	}
 }
 
-// NewInteractiveIconChoice constructs an icon-backed selector.
+// NewInteractiveIconChoice constructs an icon-backed selector over PNG
+// images. A zero width or height fits the largest image.
 func NewInteractiveIconChoice(width, height uint16, images []string, opts ...InteractiveIconChoiceOption) *InteractiveIconChoice {
+	return NewInteractiveImageChoice(width, height, pngSources(images), opts...)
+}
+
+// NewInteractiveImageChoice constructs an icon-backed selector over images of
+// any source. A zero width or height fits the largest image.
+func NewInteractiveImageChoice(width, height uint16, images []ui.ImageSource, opts ...InteractiveIconChoiceOption) *InteractiveIconChoice {
 	cfg := iconChoiceConfig{}
 	for _, opt := range opts {
 		opt(&cfg)
@@ -48,9 +66,9 @@ func NewInteractiveIconChoice(width, height uint16, images []string, opts ...Int
 
 	choice := &InteractiveIconChoice{}
 	selectorOpts := cfg.selectorOpts
-	selectorOpts = append(selectorOpts, WithSelectorChange[string](func(i int, image string) {
+	selectorOpts = append(selectorOpts, WithSelectorChange[ui.ImageSource](func(i int, image ui.ImageSource) {
 		choice.current = image
-		choice.Icon.SetImage(image)
+		choice.Icon.SetSource(image)
 		if cfg.onChange != nil {
 			cfg.onChange(i, image)
 		}
@@ -61,23 +79,37 @@ func NewInteractiveIconChoice(width, height uint16, images []string, opts ...Int
 	current, _ := selector.Current()
 	choice.current = current
 
-	icon := NewIcon(width, height, func() string { return choice.current })
+	icon := NewImageIcon(width, height, func() ui.ImageSource { return choice.current })
 	choice.Icon = icon
-	choice.Icon.SetImage(current)
+	icon.owner = choice
+	choice.Icon.SetSource(current)
+	choice.Width, choice.Height = choice.PreferredSize()
 	return choice
 }
 
+// MinSize returns the dimensions of the largest image.
+func (c *InteractiveIconChoice) MinSize() (uint16, uint16) {
+	return imageSize(c.selector.Items()...)
+}
+
+// PreferredSize sizes automatic dimensions to fit every image.
+func (c *InteractiveIconChoice) PreferredSize() (uint16, uint16) {
+	w, h := c.MinSize()
+	return c.hint.resolve(&c.WidgetBase, w, h)
+}
+
 // Enabled reports whether the choice reacts to input.
 func (c *InteractiveIconChoice) Enabled() bool {
 	return c.selector.Enabled()
 }
 
-// SetEnabled toggles input handling.
+// SetEnabled toggles input handling; the image is dimmed while disabled.
 func (c *InteractiveIconChoice) SetEnabled(v bool) {
 	c.selector.SetEnabled(v)
 	if !v {
 		c.Icon.SetSelected(false)
 	}
+	c.Invalidate()
 }
 
 // Interact processes navigation commands and updates the active icon image.
@@ -89,13 +121,13 @@ func (c *InteractiveIconChoice) Interact(cmd ui.UserCommand) bool {
 		}
 		image, _ := c.selector.Current()
 		c.current = image
-		c.Icon.SetImage(image)
+		c.Icon.SetSource(image)
 		return true
 	}
 	return c.Icon.WidgetBase.Interact(cmd)
 }
 
 // Selector provides access to the underlying selector for advanced coordination.
-func (c *InteractiveIconChoice) Selector() *InteractiveSelector[string] {
+func (c *InteractiveIconChoice) Selector() *InteractiveSelector[ui.ImageSource] {
 	return c.selector
 }
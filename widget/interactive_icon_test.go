@@ -1,6 +1,7 @@
 package widget
 
 import (
+	"image/color"
 	"testing"
 
 	ui "github.com/itohio/tinygui"
@@ -46,3 +47,45 @@ func TestInteractiveIconDisabled(t *testing.T) {
 	require.True(t, widget.Enabled())
 	require.True(t, widget.Interact(ui.UP))
 }
+
+func TestIconsDrawAnyImageSource(t *testing.T) {
+	small := ui.NewRGB565Image(2, 1, []uint16{0xF800, 0x07E0})
+	large := ui.NewMaskImage(3, 2, []uint8{0xE0, 0xA0}, color.RGBA{255, 255, 255, 255}, color.RGBA{})
+
+	icon := NewImageIcon(0, 0, func() ui.ImageSource { return small })
+	require.Equal(t, [2]uint16{2, 1}, [2]uint16{icon.Width, icon.Height})
+	fb := ui.NewFramebuffer(3, 2, ui.PixelFormatRGB888)
+	ctx := ui.NewContext(fb, 3, 2, 0, 0)
+	ui.Redraw(&ctx, icon, color.RGBA{})
+	require.Equal(t, color.RGBA{255, 0, 0, 255}, fb.GetPixel(0, 0))
+	require.False(t, icon.Dirty())
+	icon.SetSource(large)
+	require.True(t, icon.Dirty())
+
+	var changed ui.ImageSource
+	choice := NewInteractiveImageChoice(0, 0, []ui.ImageSource{small, large},
+		WithImageChoiceChange(func(_ int, src ui.ImageSource) { changed = src }))
+	require.Equal(t, [2]uint16{3, 2}, [2]uint16{choice.Width, choice.Height})
+	require.True(t, choice.Interact(ui.NEXT))
+	require.Equal(t, ui.ImageSource(large), changed)
+	require.Equal(t, ui.ImageSource(large), choice.Source())
+	require.Empty(t, choice.Image())
+
+	cycling := NewInteractiveImageIcon(0, 0, []ui.ImageSource{small, large})
+	require.True(t, cycling.Interact(ui.UP))
+	require.Equal(t, ui.ImageSource(large), cycling.Source())
+}
+
+func TestPNGIconDirtyCheckDoesNotAllocate(t *testing.T) {
+	payload := "not a png"
+	icon := NewIcon(4, 4, func() string { return payload })
+	ctx := ui.NewContext(mockDisplay{}, 4, 4, 0, 0)
+	ui.Redraw(&ctx, icon, color.RGBA{})
+	require.False(t, icon.Dirty())
+	require.Zero(t, testing.AllocsPerRun(10, func() { icon.Dirty() }))
+
+	payload = "other"
+	require.True(t, icon.Dirty())
+	require.Equal(t, "other", icon.Image())
+	require.Equal(t, ui.ImageSource(ui.PNGImage("other")), icon.Source())
+}
@@ -37,8 +37,9 @@ func NewInteractiveLabel[T Number](width, height uint16, opts ...InteractiveOpti
 		formatter: func(v T) string { return fmt.Sprintf("%v", v) },
 	}
 
-	label := NewLabel(width, height, nil, nil, color.RGBA{})
+	label := NewLabel(width, height, nil, nil, color.RGBA{}, WithLabelSymbols())
 	label.SetTextProvider(func() string { return l.displayText() })
+	label.owner = l
 	l.Label = label
 
 	for _, opt := range opts {
@@ -70,14 +71,18 @@ func (l *InteractiveLabel[T]) Enabled() bool {
 	return l.enabled
 }
 
-// SetEnabled toggles interaction ability.
+// SetEnabled toggles interaction ability; a disabled label is drawn greyed.
 func (l *InteractiveLabel[T]) SetEnabled(v bool) {
 	l.enabled = v
 	if !v {
 		l.SetSelected(false)
 	}
+	l.Invalidate()
 }
 
+// Editing reports whether a value change is pending confirmation.
+func (l *InteractiveLabel[T]) Editing() bool { return l.selecting }
+
 // Interact handles user commands.
 func (l *InteractiveLabel[T]) Interact(cmd ui.UserCommand) bool {
 	if !l.enabled {
@@ -146,6 +151,10 @@ func (l *InteractiveLabel[T]) SetSelected(sel bool) {
 	}
 }
 
+// DrawsFocus reports true: the ▲/▼ markers show the selection, so containers
+// do not decorate the label.
+func (l *InteractiveLabel[T]) DrawsFocus() bool { return true }
+
 func (l *InteractiveLabel[T]) displayText() string {
 	text := l.formatter(l.pending)
 	if l.enabled && l.Selected() {
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	ui "github.com/itohio/tinygui"
+	"github.com/itohio/tinygui/text"
 	"github.com/stretchr/testify/require"
 	"tinygo.org/x/tinyfont"
 )
@@ -59,3 +60,23 @@ func TestInteractiveLabelDisable(t *testing.T) {
 	require.False(t, label.Interact(ui.UP))
 	require.Equal(t, float32(0), label.pending)
 }
+
+func TestInteractiveLabelDrawsMarkersFromSymbols(t *testing.T) {
+	value := float32(1)
+	label := NewInteractiveLabel[float32](40, 8,
+		WithValue(&value),
+		WithFont[float32](&tinyfont.TomThumb),
+		WithTextColor[float32](color.RGBA{255, 255, 255, 255}),
+	)
+	require.True(t, text.Covers(label.Label.Font(), '▲'))
+
+	label.SetSelected(true)
+	fb := ui.NewFramebuffer(40, 8, ui.PixelFormatRGB888)
+	ctx := ui.NewContext(fb, 40, 8, 0, 0)
+	label.Draw(&ctx)
+	lit := false
+	for y := int16(0); y < 8; y++ {
+		lit = lit || fb.GetPixel(1, y) != (color.RGBA{})
+	}
+	require.True(t, lit, "▲ marker should be drawn at the left edge")
+}
@@ -172,9 +172,13 @@ func WithForeground[T Number](c color.RGBA) InteractiveOption[T] {
 	}
 }
 
-// WithBackground sets gauge background colour.
+// WithBackground sets gauge background colour, or the colour labels clear
+// behind changing text.
 func WithBackground[T Number](c color.RGBA) InteractiveOption[T] {
 	return InteractiveOption[T]{
+		applyLabel: func(l *InteractiveLabel[T]) {
+			l.SetBackground(c)
+		},
 		applyGauge: func(g *InteractiveGauge[T]) {
 			g.Background = c
 		},
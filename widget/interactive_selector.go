@@ -61,6 +61,11 @@ func (s *InteractiveSelector[T]) SetEnabled(v bool) {
 	s.enabled = v
 }
 
+// Items returns the values being selected from.
+func (s *InteractiveSelector[T]) Items() []T {
+	return s.items
+}
+
 // Current returns the currently selected value if any.
 func (s *InteractiveSelector[T]) Current() (T, bool) {
 	var zero T
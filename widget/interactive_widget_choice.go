@@ -82,6 +82,7 @@ func (c *InteractiveWidgetChoice[T]) SetEnabled(v bool) {
 	if !v {
 		c.SetSelected(false)
 	}
+	c.Invalidate()
 }
 
 // Draw renders the currently selected widget.
@@ -95,6 +96,23 @@ func (c *InteractiveWidgetChoice[T]) Draw(ctx ui.Context) {
 	widget.Draw(childCtx)
 }
 
+// Dirty reports whether the choice switched widgets or the current widget needs redrawing.
+func (c *InteractiveWidgetChoice[T]) Dirty() bool {
+	if c.WidgetBase.Dirty() {
+		return true
+	}
+	widget := c.currentWidget()
+	return widget != nil && ui.NeedsRedraw(widget)
+}
+
+// ClearDirty marks both the choice and its current widget as drawn.
+func (c *InteractiveWidgetChoice[T]) ClearDirty() {
+	c.WidgetBase.ClearDirty()
+	if widget := c.currentWidget(); widget != nil {
+		ui.MarkClean(widget)
+	}
+}
+
 // Interact processes navigation commands or delegates to the active child.
 func (c *InteractiveWidgetChoice[T]) Interact(cmd ui.UserCommand) bool {
 	if !c.config.enabled {
@@ -172,6 +190,7 @@ func (c *InteractiveWidgetChoice[T]) apply(index int, value T) {
 	}
 
 	c.currentIndex = index
+	c.Invalidate()
 	widget := ui.Widget(value)
 	if widget != nil {
 		widget.SetParent(c)
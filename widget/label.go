@@ -2,8 +2,12 @@ package widget
 
 import (
 	"image/color"
+	"strings"
 
 	ui "github.com/itohio/tinygui"
+	"github.com/itohio/tinygui/drawing"
+	"github.com/itohio/tinygui/i18n"
+	"github.com/itohio/tinygui/text"
 	"tinygo.org/x/tinyfont"
 )
 
@@ -13,39 +17,231 @@ type Label struct {
 	font  tinyfont.Fonter
 	text  func() string
 	color color.RGBA
+	style text.Style
+	wrap  bool
+	lines []string
+	drawn string
+	hint  sizeHint
+	// background clears the text area before drawing; zero uses the theme.
+	background color.RGBA
+	// extent is the area covered by the text last drawn, relative to the
+	// label origin.
+	extent ui.Rect
+	// owner is the widget embedding the label, whose state styles it.
+	owner ui.Widget
+	// symbols chains text.Symbols behind the label font.
+	symbols bool
+	chain   text.Chain
+	fonts   [2]tinyfont.Fonter
 }
 
-// NewLabel constructs a label of fixed size, font, and colour.
-func NewLabel(w, h uint16, font tinyfont.Fonter, text func() string, color color.RGBA) *Label {
-	if font == nil {
-		font = &tinyfont.TomThumb
+// LabelOption customises a Label.
+type LabelOption func(*Label)
+
+// WithLabelAlign positions the text inside the label. Labels default to left
+// alignment on the bottom baseline.
+func WithLabelAlign(h text.HAlign, v text.VAlign) LabelOption {
+	return func(l *Label) {
+		l.style.H = h
+		l.style.V = v
+	}
+}
+
+// WithLabelEllipsis truncates text wider than the label and ends it with
+// ellipsis, e.g. "...".
+func WithLabelEllipsis(ellipsis string) LabelOption {
+	return func(l *Label) {
+		l.style.Ellipsis = ellipsis
+	}
+}
+
+// WithLabelWrap word-wraps the text over as many lines as fit the label height.
+func WithLabelWrap() LabelOption {
+	return func(l *Label) {
+		l.wrap = true
+	}
+}
+
+// WithLabelBackground clears the union of the previous and the new text
+// extent with c before drawing, so changing values leave no stale pixels
+// without clearing the screen. Labels otherwise use the RoleText theme
+// background and clear nothing when it is unset.
+func WithLabelBackground(c color.RGBA) LabelOption {
+	return func(l *Label) {
+		l.background = c
+	}
+}
+
+// WithLabelSymbols draws glyphs the label font lacks, such as ° or ▲, from
+// text.Symbols and anything else as '?'.
+func WithLabelSymbols() LabelOption {
+	return func(l *Label) {
+		l.symbols = true
 	}
-	if text == nil {
-		text = func() string { return "" }
+}
+
+// NewLabel constructs a label of fixed size, font, and colour. A nil font or
+// zero colour is taken from the theme when drawing. A zero width or height is
+// computed from the font and the initial text; labels with an automatic height
+// align to the bottom edge so descenders stay inside.
+func NewLabel(w, h uint16, font tinyfont.Fonter, content func() string, color color.RGBA, opts ...LabelOption) *Label {
+	if content == nil {
+		content = func() string { return "" }
 	}
-	return &Label{
+	l := &Label{
 		WidgetBase: ui.NewWidgetBase(w, h),
 		font:       font,
-		text:       text,
+		text:       content,
 		color:      color,
+		hint:       newSizeHint(w, h),
+	}
+	for _, opt := range opts {
+		opt(l)
+	}
+	if l.hint.autoH && l.style.V == text.AlignBaseline {
+		l.style.V = text.AlignBottom
+	}
+	l.Width, l.Height = l.PreferredSize()
+	return l
+}
+
+// NewLabelID constructs a label showing the translatable string id. The text
+// follows the active i18n locale; automatic dimensions are measured from the
+// locale active at construction.
+func NewLabelID(w, h uint16, font tinyfont.Fonter, id i18n.StringID, color color.RGBA, opts ...LabelOption) *Label {
+	return NewLabel(w, h, font, i18n.Text(id), color, opts...)
+}
+
+// MinSize returns the size of the ellipsis when the label truncates, of the
+// widest word when it wraps, and of the whole text otherwise.
+func (l *Label) MinSize() (uint16, uint16) {
+	s := l.text()
+	switch {
+	case l.style.Ellipsis != "":
+		fw, fh := textSize(l.Font(), s)
+		ew, _ := textSize(l.Font(), l.style.Ellipsis)
+		return min(fw, ew), fh
+	case l.wrap:
+		var w uint16
+		for _, word := range strings.Fields(s) {
+			ww, _ := textSize(l.Font(), word)
+			w = max(w, ww)
+		}
+		_, h := textSize(l.Font(), "")
+		return w, h
 	}
+	return textSize(l.Font(), s)
+}
+
+// PreferredSize returns the size of the current text on a single line for
+// automatic dimensions and the configured size otherwise.
+func (l *Label) PreferredSize() (uint16, uint16) {
+	w, h := textSize(l.Font(), l.text())
+	return l.hint.resolve(&l.WidgetBase, w, h)
 }
 
 func (l *Label) Draw(ctx ui.Context) {
 	x, y := ctx.DisplayPos()
-	tinyfont.WriteLine(ctx.D(), l.font, x, y+int16(l.Height), l.text(), l.color)
+	l.drawn = l.text()
+	style := l.themed(ctx)
+	box := ui.Rect{X: x, Y: y, W: int16(l.Width), H: int16(l.Height)}
+	if !l.wrap {
+		l.clear(ctx, style, box, l.drawn)
+		text.Write(ctx.D(), style.Font, box, style.Foreground, l.style, l.drawn)
+		return
+	}
+	l.lines = text.Wrap(style.Font, l.drawn, box.W, l.lines[:0])
+	lines := l.lines
+	if fit := int(box.H) / max(1, int(style.Font.GetYAdvance())); len(lines) > fit {
+		lines = lines[:max(1, fit)]
+	}
+	l.clear(ctx, style, box, lines...)
+	text.Write(ctx.D(), style.Font, box, style.Foreground, l.style, lines...)
+}
+
+// clear fills the union of the text extent drawn last and the one about to be
+// drawn with the background colour, when there is one.
+func (l *Label) clear(ctx ui.Context, style ui.Style, box ui.Rect, lines ...string) {
+	bg := l.background
+	if isZeroColor(bg) {
+		bg = style.Background
+	}
+	if isZeroColor(bg) || ctx.D() == nil {
+		return
+	}
+	area := text.Extent(style.Font, box, l.style, lines...)
+	area.X -= box.X
+	area.Y -= box.Y
+	dirty := area.Union(l.extent)
+	l.extent = area
+	if !dirty.Empty() {
+		drawing.FillRect(ctx.D(), box.X+dirty.X, box.Y+dirty.Y, dirty.W, dirty.H, bg)
+	}
+}
+
+// themed resolves the text style, with the label's own font and colour taking
+// precedence over the theme.
+func (l *Label) themed(ctx ui.Context) ui.Style {
+	var self ui.Widget = l
+	if l.owner != nil {
+		self = l.owner
+	}
+	style := themed(ctx, self, ui.RoleText, l.font, l.color)
+	style.Font = l.withSymbols(style.Font)
+	return style
+}
+
+// Font returns the label's font, or the default theme font when none was set.
+func (l *Label) Font() tinyfont.Fonter {
+	return l.withSymbols(defaultFont(l.font, ui.RoleText))
+}
+
+// withSymbols chains text.Symbols behind font when the label asks for it.
+func (l *Label) withSymbols(font tinyfont.Fonter) tinyfont.Fonter {
+	if !l.symbols {
+		return font
+	}
+	l.fonts = [2]tinyfont.Fonter{font, &text.Symbols}
+	l.chain = text.Chain{Fonts: l.fonts[:], Replacement: text.DefaultReplacement}
+	return &l.chain
+}
+
+// Dirty reports whether the label was invalidated or its text changed since
+// it was last drawn.
+func (l *Label) Dirty() bool {
+	return l.WidgetBase.Dirty() || l.text() != l.drawn
 }
 
 // SetFont updates the font used to draw the label.
 func (l *Label) SetFont(font tinyfont.Fonter) {
 	if font != nil {
 		l.font = font
+		l.Invalidate()
 	}
 }
 
+// SetStyle replaces the alignment and truncation settings.
+func (l *Label) SetStyle(style text.Style) {
+	l.style = style
+	l.Invalidate()
+}
+
+// Style returns the alignment and truncation settings.
+func (l *Label) Style() text.Style {
+	return l.style
+}
+
+// SetBackground sets the colour clearing the text area before drawing. Zero
+// falls back to the theme background.
+func (l *Label) SetBackground(c color.RGBA) {
+	l.background = c
+	l.Invalidate()
+}
+
 // SetColor updates the text colour.
 func (l *Label) SetColor(color color.RGBA) {
 	l.color = color
+	l.Invalidate()
 }
 
 // SetText assigns a static text provider.
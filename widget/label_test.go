@@ -0,0 +1,97 @@
+package widget
+
+import (
+	"image/color"
+	"testing"
+
+	ui "github.com/itohio/tinygui"
+	"github.com/itohio/tinygui/text"
+	"github.com/stretchr/testify/require"
+	"tinygo.org/x/tinyfont"
+	"tinygo.org/x/tinyfont/proggy"
+)
+
+func countColor(fb *ui.Framebuffer, area ui.Rect, c color.RGBA) int {
+	n := 0
+	for y := area.Y; y < area.Y+area.H; y++ {
+		for x := area.X; x < area.X+area.W; x++ {
+			if fb.GetPixel(x, y) == c {
+				n++
+			}
+		}
+	}
+	return n
+}
+
+func TestLabelClearsUnionOfOldAndNewText(t *testing.T) {
+	white := color.RGBA{255, 255, 255, 255}
+	navy := color.RGBA{0, 0, 80, 255}
+	red := color.RGBA{255, 0, 0, 255}
+	value := "100"
+	label := NewLabel(40, 8, &tinyfont.TomThumb, func() string { return value }, white,
+		WithLabelBackground(navy), WithLabelAlign(text.AlignRight, text.AlignBaseline))
+
+	fb := ui.NewFramebuffer(40, 8, ui.PixelFormatRGB888)
+	ctx := ui.NewContext(fb, 40, 8, 0, 0)
+	fb.FillScreen(red)
+	label.Draw(&ctx)
+	wide := text.Width(&tinyfont.TomThumb, "100")
+	require.Equal(t, int(40-wide)*8, countColor(fb, ui.Rect{W: 40, H: 8}, red))
+
+	value = "99"
+	label.Draw(&ctx)
+	// The "1" is gone and the screen left of the old text is untouched.
+	narrow := text.Width(&tinyfont.TomThumb, "99")
+	require.Zero(t, countColor(fb, ui.Rect{X: 40 - wide, W: wide - narrow, H: 8}, white))
+	require.Equal(t, int(40-wide)*8, countColor(fb, ui.Rect{W: 40, H: 8}, red))
+}
+
+func TestLabelWithoutBackgroundLeavesScreen(t *testing.T) {
+	red := color.RGBA{255, 0, 0, 255}
+	label := NewLabel(40, 8, &tinyfont.TomThumb, func() string { return "" }, color.RGBA{255, 255, 255, 255})
+	fb := ui.NewFramebuffer(40, 8, ui.PixelFormatRGB888)
+	ctx := ui.NewContext(fb, 40, 8, 0, 0)
+	fb.FillScreen(red)
+	label.Draw(&ctx)
+	require.Equal(t, 40*8, countColor(fb, ui.Rect{W: 40, H: 8}, red))
+}
+
+func TestMultilineClearsPreviousRows(t *testing.T) {
+	navy := color.RGBA{0, 0, 80, 255}
+	white := color.RGBA{255, 255, 255, 255}
+	m := NewMultilineLabel(40, 6, 2, WithMultilineBackground(navy), WithMultilineColor(white))
+	fb := ui.NewFramebuffer(40, 12, ui.PixelFormatRGB888)
+	ctx := ui.NewContext(fb, 40, 12, 0, 0)
+
+	m.SetLines([]string{"temperature"})
+	m.Draw(&ctx)
+	m.SetLines([]string{"ok"})
+	m.Draw(&ctx)
+	ok := text.Width(&tinyfont.TomThumb, "ok")
+	require.Zero(t, countColor(fb, ui.Rect{X: ok, W: 40 - ok, H: 12}, white))
+}
+
+func TestMultilineWrapsWithThemeFont(t *testing.T) {
+	wide := &proggy.TinySZ8pt7b
+	line := "pump 2 stalled, retrying in 5s"
+	log := NewInteractiveLog(40, 10, 2)
+	log.SetCapacity(20)
+	log.Append(line)
+	require.Equal(t, text.Wrap(&tinyfont.TomThumb, line, 40, nil), log.Lines())
+
+	fb := ui.NewFramebuffer(40, 20, ui.PixelFormatRGB888)
+	ctx := ui.NewContext(fb, 40, 20, 0, 0)
+	ctx.SetTheme(ui.NewTheme(ui.Style{Font: wide}))
+	log.Draw(&ctx)
+	rows := text.Wrap(wide, line, 40, nil)
+	require.Greater(t, len(rows), len(text.Wrap(&tinyfont.TomThumb, line, 40, nil)))
+	require.Equal(t, rows, log.Lines())
+	for _, row := range log.Lines() {
+		require.LessOrEqual(t, text.Width(wide, row), int16(40), row)
+	}
+	require.Equal(t, log.maxStart(), log.viewStart, "the view keeps following the newest rows")
+
+	ctx.SetTheme(ui.NewTheme(ui.Style{Font: &tinyfont.TomThumb}))
+	log.Draw(&ctx)
+	require.Equal(t, text.Wrap(&tinyfont.TomThumb, line, 40, nil), log.Lines())
+}
@@ -0,0 +1,57 @@
+package widget
+
+import (
+	ui "github.com/itohio/tinygui"
+	"github.com/itohio/tinygui/text"
+	"tinygo.org/x/tinyfont"
+)
+
+// sizeHint remembers which dimensions a widget was constructed with as zero
+// and therefore computes from its content.
+type sizeHint struct {
+	autoW, autoH bool
+}
+
+func newSizeHint(w, h uint16) sizeHint {
+	return sizeHint{autoW: w == 0, autoH: h == 0}
+}
+
+// resolve returns the content size for automatic dimensions and the current
+// widget size for explicit ones.
+func (s sizeHint) resolve(base *ui.WidgetBase, w, h uint16) (uint16, uint16) {
+	if !s.autoW {
+		w = base.Width
+	}
+	if !s.autoH {
+		h = base.Height
+	}
+	return w, h
+}
+
+// textSize measures a single line of s.
+func textSize(font tinyfont.Fonter, s string) (uint16, uint16) {
+	return uint16(max(0, text.Width(font, s))), uint16(max(0, text.FontMetrics(font).Height()))
+}
+
+// imageSize returns the largest dimensions among images.
+func imageSize(images ...ui.ImageSource) (uint16, uint16) {
+	var w, h uint16
+	for _, img := range images {
+		if img == nil {
+			continue
+		}
+		iw, ih := img.Size()
+		w = max(w, iw)
+		h = max(h, ih)
+	}
+	return w, h
+}
+
+// pngSources wraps PNG payloads as image sources.
+func pngSources(images []string) []ui.ImageSource {
+	sources := make([]ui.ImageSource, len(images))
+	for i, img := range images {
+		sources[i] = ui.PNGImage(img)
+	}
+	return sources
+}
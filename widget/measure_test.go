@@ -0,0 +1,77 @@
+package widget
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/png"
+	"testing"
+
+	ui "github.com/itohio/tinygui"
+	"github.com/itohio/tinygui/text"
+	"github.com/stretchr/testify/require"
+	"tinygo.org/x/tinyfont"
+)
+
+func encodePNG(t *testing.T, w, h int) string {
+	t.Helper()
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, image.NewRGBA(image.Rect(0, 0, w, h))))
+	return buf.String()
+}
+
+func TestLabelAutoSize(t *testing.T) {
+	white := color.RGBA{255, 255, 255, 255}
+	auto := NewLabel(0, 0, &tinyfont.TomThumb, func() string { return "Pump" }, white)
+	w, h := auto.Size()
+	require.Equal(t, uint16(16), w)
+	require.Equal(t, uint16(6), h)
+
+	// Explicit dimensions are kept and reported as preferred.
+	fixed := NewLabel(40, 0, &tinyfont.TomThumb, func() string { return "Pump" }, white)
+	w, h = fixed.PreferredSize()
+	require.Equal(t, uint16(40), w)
+	require.Equal(t, uint16(6), h)
+
+	ellipsis := NewLabel(10, 8, &tinyfont.TomThumb, func() string { return "Temperature" }, white, WithLabelEllipsis("..."))
+	w, _ = ellipsis.MinSize()
+	require.Equal(t, uint16(6), w)
+}
+
+func TestToggleAndChoiceAutoSize(t *testing.T) {
+	toggle := NewToggle(0, 0, &tinyfont.TomThumb, color.RGBA{255, 255, 255, 255}, "ON", "OFF", color.RGBA{0, 255, 0, 255}, color.RGBA{255, 0, 0, 255}, nil, nil)
+	w, h := toggle.Size()
+	require.Equal(t, uint16(12+4), w)
+	require.Equal(t, uint16(8), h)
+
+	choice := NewInteractiveLabelChoice(0, 0, []string{"Low", "Medium", "High"})
+	w, h = choice.Size()
+	require.Equal(t, uint16(text.Width(&tinyfont.TomThumb, "Medium")), w)
+	require.Equal(t, uint16(6), h)
+	// The size fits the longest option regardless of the one shown.
+	choice.Interact(ui.UP)
+	w2, _ := choice.PreferredSize()
+	require.Equal(t, w, w2)
+}
+
+func TestIconSizeFromPNG(t *testing.T) {
+	small, large := encodePNG(t, 8, 12), encodePNG(t, 16, 10)
+
+	icon := NewIcon(0, 0, func() string { return small })
+	w, h := icon.Size()
+	require.Equal(t, uint16(8), w)
+	require.Equal(t, uint16(12), h)
+
+	choice := NewInteractiveIconChoice(0, 0, []string{small, large})
+	w, h = choice.Size()
+	require.Equal(t, uint16(16), w)
+	require.Equal(t, uint16(12), h)
+
+	cycling := NewInteractiveIcon(0, 20, []string{small, large})
+	w, h = cycling.Size()
+	require.Equal(t, uint16(16), w)
+	require.Equal(t, uint16(20), h)
+
+	_, _, ok := ui.PngSize("not a png")
+	require.False(t, ok)
+}
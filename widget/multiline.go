@@ -4,6 +4,8 @@ import (
 	"image/color"
 
 	ui "github.com/itohio/tinygui"
+	"github.com/itohio/tinygui/drawing"
+	"github.com/itohio/tinygui/text"
 	"tinygo.org/x/tinyfont"
 )
 
@@ -20,20 +22,30 @@ const (
 // MultilineOption customises the behaviour of a multiline widget.
 type MultilineOption func(*MultilineBase)
 
-// WithMultilineFont sets the font used for drawing text.
+// WithMultilineFont sets the font used for drawing text instead of the
+// theme's.
 func WithMultilineFont(font tinyfont.Fonter) MultilineOption {
 	return func(base *MultilineBase) {
 		base.font = font
 	}
 }
 
-// WithMultilineColor sets the text colour.
+// WithMultilineColor sets the text colour instead of the theme's.
 func WithMultilineColor(col color.RGBA) MultilineOption {
 	return func(base *MultilineBase) {
 		base.color = col
 	}
 }
 
+// WithMultilineBackground clears the rows drawn last and the rows about to be
+// drawn with col before drawing. Without it the RoleText theme background is
+// used, and nothing is cleared when that is unset.
+func WithMultilineBackground(col color.RGBA) MultilineOption {
+	return func(base *MultilineBase) {
+		base.background = col
+	}
+}
+
 // WithMultilineOrder defines whether the newest line is drawn at the bottom or top.
 func WithMultilineOrder(order MultilineOrder) MultilineOption {
 	return func(base *MultilineBase) {
@@ -41,24 +53,45 @@ func WithMultilineOrder(order MultilineOrder) MultilineOption {
 	}
 }
 
-// MultilineBase stores shared properties for multiline widgets.
+// WithMultilineWrap enables or disables word wrapping of long lines, which is
+// on by default.
+func WithMultilineWrap(enabled bool) MultilineOption {
+	return func(base *MultilineBase) {
+		base.wrap = enabled
+	}
+}
+
+// MultilineBase stores shared properties for multiline widgets. Lines wider
+// than the widget are word-wrapped into several rows, so Lines, MaxLines and
+// scrolling all count rows. Rows are wrapped with the font they are drawn
+// with and wrapped again when it changes, e.g. under a new theme.
 type MultilineBase struct {
 	ui.WidgetBase
 	font     tinyfont.Fonter
 	color    color.RGBA
 	maxLines int
 	order    MultilineOrder
+	wrap     bool
 	lines    []string
+	// source holds the lines as given while wrapping, wrapFont the font
+	// lines was wrapped with.
+	source   []string
+	wrapFont tinyfont.Fonter
+	// capacity caps the number of rows kept, zero for no limit.
+	capacity int
+	// background clears the text area before drawing; zero uses the theme.
+	background color.RGBA
+	// extent is the width of the widest row drawn last.
+	extent int16
 }
 
 // NewMultilineBase constructs the shared base. lineHeight represents the height of a single row.
 func NewMultilineBase(width, lineHeight uint16, maxLines int, opts ...MultilineOption) *MultilineBase {
 	base := &MultilineBase{
 		WidgetBase: ui.NewWidgetBase(width, lineHeight*uint16(maxLines)),
-		font:       &tinyfont.TomThumb,
-		color:      color.RGBA{255, 255, 255, 255},
 		maxLines:   maxLines,
 		order:      MultilineNewestOnBottom,
+		wrap:       true,
 		lines:      nil,
 	}
 	for _, opt := range opts {
@@ -69,7 +102,76 @@ func NewMultilineBase(width, lineHeight uint16, maxLines int, opts ...MultilineO
 
 // SetLines replaces the base content with the provided lines.
 func (m *MultilineBase) SetLines(lines []string) {
-	m.lines = append(m.lines[:0], lines...)
+	m.lines, m.source = m.lines[:0], m.source[:0]
+	for _, line := range lines {
+		m.appendLine(line)
+	}
+	m.trim()
+	m.Invalidate()
+}
+
+// appendLine adds line, wrapped to the widget width when enabled. Until the
+// widget is drawn the font of the default theme is assumed.
+func (m *MultilineBase) appendLine(line string) {
+	if !m.wrap {
+		m.lines = append(m.lines, line)
+		return
+	}
+	if m.wrapFont == nil {
+		m.wrapFont = defaultFont(m.font, ui.RoleText)
+	}
+	m.source = append(m.source, line)
+	m.lines = text.Wrap(m.wrapFont, line, int16(m.Width), m.lines)
+}
+
+// rewrap wraps the lines again with font when the rows were wrapped with
+// another one, reporting whether they were.
+func (m *MultilineBase) rewrap(font tinyfont.Fonter) bool {
+	if !m.wrap || font == m.wrapFont {
+		return false
+	}
+	m.wrapFont = font
+	m.lines = m.lines[:0]
+	for _, line := range m.source {
+		m.lines = text.Wrap(font, line, int16(m.Width), m.lines)
+	}
+	m.trim()
+	return true
+}
+
+// refit wraps the rows for the font drawn in ctx and returns start moved
+// along, so a view following the newest rows at the bottom keeps doing so.
+func (m *MultilineBase) refit(ctx ui.Context, start int) int {
+	bottom := start >= m.maxStart()
+	if !m.rewrap(themed(ctx, m, ui.RoleText, m.font, m.color).Font) {
+		return start
+	}
+	if bottom && m.order == MultilineNewestOnBottom {
+		return m.maxStart()
+	}
+	return clampInt(start, 0, m.maxStart())
+}
+
+// trim drops the oldest rows beyond the capacity, and the lines no longer
+// needed to wrap the rest. It reports whether anything was dropped.
+func (m *MultilineBase) trim() bool {
+	if m.capacity <= 0 || len(m.lines) <= m.capacity {
+		return false
+	}
+	m.lines = dropFront(m.lines, len(m.lines)-m.capacity)
+	// Every line wraps to at least one row, so the newest capacity lines
+	// cover the rows kept.
+	if len(m.source) > m.capacity {
+		m.source = dropFront(m.source, len(m.source)-m.capacity)
+	}
+	return true
+}
+
+// dropFront removes the first n elements of s in place.
+func dropFront(s []string, n int) []string {
+	kept := copy(s, s[n:])
+	clear(s[kept:])
+	return s[:kept]
 }
 
 // Lines returns the stored lines.
@@ -94,15 +196,19 @@ func (m *MultilineBase) Draw(ctx ui.Context) {
 
 // DrawAt renders the view starting at the provided offset.
 func (m *MultilineBase) DrawAt(ctx ui.Context, start int) {
+	style := themed(ctx, m, ui.RoleText, m.font, m.color)
+	m.rewrap(style.Font)
 	total := len(m.lines)
+	start = clampInt(start, 0, m.maxStart())
+	visible := min(m.maxLines, total)
+	first := start
+	if m.order == MultilineNewestOnTop {
+		first = max(0, total-start-visible)
+	}
+	m.clear(ctx, style, m.lines[first:first+visible])
 	if total == 0 {
 		return
 	}
-	start = clampInt(start, 0, m.maxStart())
-	visible := m.maxLines
-	if visible > total {
-		visible = total
-	}
 
 	x, y := ctx.DisplayPos()
 	lineHeight := int16(m.Height) / int16(m.maxLines)
@@ -117,7 +223,7 @@ func (m *MultilineBase) DrawAt(ctx ui.Context, start int) {
 			if idx < 0 {
 				break
 			}
-			tinyfont.WriteLine(ctx.D(), m.font, x, y+lineHeight, m.lines[idx], m.color)
+			tinyfont.WriteLine(ctx.D(), style.Font, x, y+lineHeight, m.lines[idx], style.Foreground)
 			y += lineHeight
 		}
 	default: // MultilineNewestOnBottom
@@ -126,12 +232,33 @@ func (m *MultilineBase) DrawAt(ctx ui.Context, start int) {
 			if idx >= total {
 				break
 			}
-			tinyfont.WriteLine(ctx.D(), m.font, x, y+lineHeight, m.lines[idx], m.color)
+			tinyfont.WriteLine(ctx.D(), style.Font, x, y+lineHeight, m.lines[idx], style.Foreground)
 			y += lineHeight
 		}
 	}
 }
 
+// clear fills the widget from the left edge to the widest of the rows drawn
+// last and rows with the background colour, when there is one.
+func (m *MultilineBase) clear(ctx ui.Context, style ui.Style, rows []string) {
+	bg := m.background
+	if isZeroColor(bg) {
+		bg = style.Background
+	}
+	if isZeroColor(bg) || ctx.D() == nil {
+		return
+	}
+	var w int16
+	for _, row := range rows {
+		w = max(w, text.Width(style.Font, row))
+	}
+	if width := max(w, m.extent); width > 0 {
+		x, y := ctx.DisplayPos()
+		drawing.FillRect(ctx.D(), x, y, min(width, int16(m.Width)), int16(m.Height), bg)
+	}
+	m.extent = w
+}
+
 func (m *MultilineBase) defaultStart() int {
 	if m.order == MultilineNewestOnBottom {
 		return m.maxStart()
@@ -170,7 +297,6 @@ func NewMultilineLabel(width, lineHeight uint16, maxLines int, opts ...Multiline
 // Log stores an append-only history capped at capacity.
 type Log struct {
 	*MultilineBase
-	capacity int
 }
 
 // SetCapacity adjusts the number of entries retained in history (minimum maxLines).
@@ -179,9 +305,8 @@ func (l *Log) SetCapacity(cap int) {
 		cap = l.maxLines
 	}
 	l.capacity = cap
-	if len(l.lines) > l.capacity {
-		excess := len(l.lines) - l.capacity
-		l.lines = append([]string(nil), l.lines[excess:]...)
+	if l.trim() {
+		l.Invalidate()
 	}
 }
 
@@ -193,19 +318,16 @@ func NewLog(width, lineHeight uint16, maxLines int, font tinyfont.Fonter, col co
 // NewLogWithOptions constructs a log view using shared multiline options.
 func NewLogWithOptions(width, lineHeight uint16, maxLines int, opts ...MultilineOption) *Log {
 	base := NewMultilineBase(width, lineHeight, maxLines, opts...)
-	log := &Log{MultilineBase: base, capacity: maxLines}
-	return log
+	base.capacity = maxLines
+	return &Log{MultilineBase: base}
 }
 
-// Append adds a new log entry, keeping only the configured capacity.
+// Append adds a new log entry, keeping only the configured capacity. Long
+// entries are wrapped over several rows.
 func (l *Log) Append(line string) {
-	l.lines = append(l.lines, line)
-	if l.capacity > 0 && len(l.lines) > l.capacity {
-		excess := len(l.lines) - l.capacity
-		if excess > 0 {
-			l.lines = append([]string(nil), l.lines[excess:]...)
-		}
-	}
+	l.appendLine(line)
+	l.trim()
+	l.Invalidate()
 }
 
 // InteractiveMultiline allows scrolling through multiline content.
@@ -222,6 +344,7 @@ func NewInteractiveMultiline(width, lineHeight uint16, maxLines int, opts ...Mul
 
 // Draw renders using the current view start offset.
 func (m *InteractiveMultiline) Draw(ctx ui.Context) {
+	m.viewStart = m.refit(ctx, m.viewStart)
 	m.MultilineBase.DrawAt(ctx, m.viewStart)
 }
 
@@ -259,6 +382,7 @@ func (m *InteractiveMultiline) scrollOlder(step int) bool {
 		newStart := clampInt(m.viewStart-step, 0, maxStart)
 		if newStart != m.viewStart {
 			m.viewStart = newStart
+			m.Invalidate()
 			return true
 		}
 		return false
@@ -266,6 +390,7 @@ func (m *InteractiveMultiline) scrollOlder(step int) bool {
 	newStart := clampInt(m.viewStart+step, 0, maxStart)
 	if newStart != m.viewStart {
 		m.viewStart = newStart
+		m.Invalidate()
 		return true
 	}
 	return false
@@ -277,6 +402,7 @@ func (m *InteractiveMultiline) scrollNewer(step int) bool {
 		newStart := clampInt(m.viewStart+step, 0, maxStart)
 		if newStart != m.viewStart {
 			m.viewStart = newStart
+			m.Invalidate()
 			return true
 		}
 		return false
@@ -284,6 +410,7 @@ func (m *InteractiveMultiline) scrollNewer(step int) bool {
 	newStart := clampInt(m.viewStart-step, 0, maxStart)
 	if newStart != m.viewStart {
 		m.viewStart = newStart
+		m.Invalidate()
 		return true
 	}
 	return false
@@ -303,6 +430,7 @@ func NewInteractiveLog(width, lineHeight uint16, maxLines int, opts ...Multiline
 
 // Draw renders the log at the current view offset.
 func (l *InteractiveLog) Draw(ctx ui.Context) {
+	l.viewStart = l.refit(ctx, l.viewStart)
 	l.MultilineBase.DrawAt(ctx, l.viewStart)
 }
 
@@ -337,6 +465,7 @@ func (l *InteractiveLog) scrollOlder(step int) bool {
 		newStart := clampInt(l.viewStart-step, 0, maxStart)
 		if newStart != l.viewStart {
 			l.viewStart = newStart
+			l.Invalidate()
 			return true
 		}
 		return false
@@ -344,6 +473,7 @@ func (l *InteractiveLog) scrollOlder(step int) bool {
 	newStart := clampInt(l.viewStart+step, 0, maxStart)
 	if newStart != l.viewStart {
 		l.viewStart = newStart
+		l.Invalidate()
 		return true
 	}
 	return false
@@ -355,6 +485,7 @@ func (l *InteractiveLog) scrollNewer(step int) bool {
 		newStart := clampInt(l.viewStart+step, 0, maxStart)
 		if newStart != l.viewStart {
 			l.viewStart = newStart
+			l.Invalidate()
 			return true
 		}
 		return false
@@ -362,6 +493,7 @@ func (l *InteractiveLog) scrollNewer(step int) bool {
 	newStart := clampInt(l.viewStart-step, 0, maxStart)
 	if newStart != l.viewStart {
 		l.viewStart = newStart
+		l.Invalidate()
 		return true
 	}
 	return false
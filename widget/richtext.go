@@ -0,0 +1,365 @@
+package widget
+
+import (
+	"image/color"
+
+	ui "github.com/itohio/tinygui"
+	"github.com/itohio/tinygui/text"
+	"tinygo.org/x/tinyfont"
+)
+
+// Span is one run of rich text: text in a font and colour, or an inline
+// RGB565 bitmap such as the arrays produced by png2bin.
+type Span struct {
+	// Text provides the span text; spaces and newlines break lines.
+	Text func() string
+	// Font and Color style the text. A nil font or zero colour is taken
+	// from the theme.
+	Font  tinyfont.Fonter
+	Color color.RGBA
+	// Bitmap, when set, is drawn instead of text with its bottom edge on
+	// the baseline.
+	Bitmap        []uint16
+	Width, Height uint16
+}
+
+// TextSpan returns a span drawing the text provided by content.
+func TextSpan(font tinyfont.Fonter, content func() string, color color.RGBA) Span {
+	return Span{Text: content, Font: font, Color: color}
+}
+
+// BitmapSpan returns a span drawing a w×h RGB565 bitmap.
+func BitmapSpan(w, h uint16, pixels []uint16) Span {
+	return Span{Bitmap: pixels, Width: w, Height: h}
+}
+
+// RichText draws a sequence of spans on shared baselines, e.g. a status line
+// mixing icons and values. It measures and wraps its spans as a unit.
+type RichText struct {
+	ui.WidgetBase
+	spans []Span
+	style text.Style
+	wrap  bool
+	hint  sizeHint
+	// dimmer greys the text while disabled, reused between draws.
+	dimmer *ui.FilterDisplayer
+
+	// Layout state, reused between draws.
+	texts   []string
+	drawn   []string
+	fonts   []tinyfont.Fonter
+	colors  []color.RGBA
+	metrics []text.Metrics
+	atoms   []richAtom
+	lines   []richLine
+}
+
+// richAtom is an unbreakable piece of a span: a word or a bitmap.
+type richAtom struct {
+	span       int
+	start, end int   // byte range of a word in the span text
+	lead       int16 // width of the spaces before the atom
+	w          int16
+	newline    bool // the atom ends its line
+}
+
+// richLine is a run of atoms [first, last) drawn on one baseline.
+type richLine struct {
+	first, last    int
+	indent, w      int16
+	ascent, height int16
+}
+
+// RichTextOption customises a RichText.
+type RichTextOption func(*RichText)
+
+// WithRichTextAlign positions the lines inside the widget. Rich text defaults
+// to left alignment on the bottom baseline, like Label.
+func WithRichTextAlign(h text.HAlign, v text.VAlign) RichTextOption {
+	return func(r *RichText) {
+		r.style.H = h
+		r.style.V = v
+	}
+}
+
+// WithRichTextWrap breaks lines at spaces, newlines and bitmaps to fit the
+// widget width. Words wider than the widget are not split.
+func WithRichTextWrap() RichTextOption {
+	return func(r *RichText) {
+		r.wrap = true
+	}
+}
+
+// NewRichText constructs rich text of fixed size. A zero width or height is
+// computed from the spans laid out on a single line.
+func NewRichText(w, h uint16, spans []Span, opts ...RichTextOption) *RichText {
+	r := &RichText{
+		WidgetBase: ui.NewWidgetBase(w, h),
+		hint:       newSizeHint(w, h),
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+	if r.hint.autoH && r.style.V == text.AlignBaseline {
+		r.style.V = text.AlignBottom
+	}
+	r.setSpans(spans)
+	r.Width, r.Height = r.PreferredSize()
+	return r
+}
+
+// Spans returns the spans drawn by the widget.
+func (r *RichText) Spans() []Span {
+	return r.spans
+}
+
+// SetSpans replaces the content. Automatic dimensions are not recomputed.
+func (r *RichText) SetSpans(spans ...Span) {
+	r.setSpans(spans)
+	r.Invalidate()
+}
+
+func (r *RichText) setSpans(spans []Span) {
+	r.spans = spans
+	n := len(spans)
+	r.texts = resize(r.texts, n)
+	r.drawn = resize(r.drawn, n)
+	r.fonts = resize(r.fonts, n)
+	r.colors = resize(r.colors, n)
+	r.metrics = resize(r.metrics, n)
+	for i := range r.drawn {
+		r.drawn[i] = ""
+	}
+}
+
+// resize returns s with length n, reusing its storage when large enough.
+func resize[T any](s []T, n int) []T {
+	if cap(s) < n {
+		return make([]T, n)
+	}
+	return s[:n]
+}
+
+// MinSize returns the size of the widest word or bitmap and the first line
+// when the text wraps, and of the single line layout otherwise.
+func (r *RichText) MinSize() (uint16, uint16) {
+	r.resolve(nil)
+	w, h := r.layout(-1)
+	if !r.wrap {
+		return w, h
+	}
+	var widest int16
+	for _, a := range r.atoms {
+		widest = max(widest, a.w)
+	}
+	return uint16(widest), uint16(r.lines[0].height)
+}
+
+// PreferredSize returns the single line size for automatic dimensions and the
+// configured size otherwise.
+func (r *RichText) PreferredSize() (uint16, uint16) {
+	r.resolve(nil)
+	w, h := r.layout(-1)
+	return r.hint.resolve(&r.WidgetBase, w, h)
+}
+
+// Dirty reports whether the widget was invalidated or a span text changed
+// since it was last drawn.
+func (r *RichText) Dirty() bool {
+	if r.WidgetBase.Dirty() {
+		return true
+	}
+	for i, s := range r.spans {
+		if s.Text != nil && s.Text() != r.drawn[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Draw lays the spans out in the widget box and draws them line by line.
+func (r *RichText) Draw(ctx ui.Context) {
+	d := ctx.D()
+	if d == nil {
+		return
+	}
+	r.resolve(ctx)
+	copy(r.drawn, r.texts)
+	width := int16(-1)
+	if r.wrap {
+		width = int16(r.Width)
+	}
+	_, total := r.layout(width)
+
+	x0, y0 := ctx.DisplayPos()
+	box := ui.Rect{X: x0, Y: y0, W: int16(r.Width), H: int16(r.Height)}
+	var bmp ui.BitmapDisplayer
+	if !ui.Enabled(r) {
+		bmp = dimmed(d, box, &r.dimmer)
+	} else {
+		bmp, _ = d.(ui.BitmapDisplayer)
+	}
+
+	y := box.Y + r.top(int16(total), box.H)
+	for _, line := range r.lines {
+		baseline := y + line.ascent
+		x := box.X + r.style.Indent(line.w, box.W) + line.indent
+		for i := line.first; i < line.last; i++ {
+			a := r.atoms[i]
+			if i > line.first {
+				x += a.lead
+			}
+			span := r.spans[a.span]
+			switch {
+			case span.Bitmap != nil:
+				n := int(span.Width) * int(span.Height)
+				if bmp != nil && len(span.Bitmap) >= n {
+					_ = bmp.DrawRGBBitmap(x, baseline-int16(span.Height), span.Bitmap[:n], int16(span.Width), int16(span.Height))
+				}
+			case a.end > a.start:
+				tinyfont.WriteLine(d, r.fonts[a.span], x, baseline, r.texts[a.span][a.start:a.end], r.colors[a.span])
+			}
+			x += a.w
+		}
+		y += line.height
+	}
+}
+
+// top returns the offset of the first line from the top of a box of height h
+// holding lines of the given total height.
+func (r *RichText) top(total, h int16) int16 {
+	switch r.style.V {
+	case text.AlignTop:
+		return 0
+	case text.AlignMiddle:
+		return (h - total) / 2
+	case text.AlignBottom:
+		return h - total
+	}
+	// Put the last baseline on the bottom edge.
+	if n := len(r.lines); n > 0 {
+		last := r.lines[n-1]
+		total -= last.height - last.ascent
+	}
+	return h - total
+}
+
+// resolve fetches the span texts and their fonts and colours, themed when a
+// context is given.
+func (r *RichText) resolve(ctx ui.Context) {
+	for i, s := range r.spans {
+		r.texts[i] = ""
+		if s.Bitmap != nil {
+			continue
+		}
+		if s.Text != nil {
+			r.texts[i] = s.Text()
+		}
+		font := defaultFont(s.Font, ui.RoleText)
+		if ctx != nil {
+			style := themed(ctx, r, ui.RoleText, s.Font, s.Color)
+			font, r.colors[i] = style.Font, style.Foreground
+		}
+		if font != r.fonts[i] {
+			r.fonts[i] = font
+			r.metrics[i] = text.FontMetrics(font)
+		}
+	}
+}
+
+// layout splits the resolved spans into atoms and lines no wider than width,
+// or a single line per paragraph when width is negative, and returns the
+// size of the laid out text.
+func (r *RichText) layout(width int16) (uint16, uint16) {
+	r.atoms = r.atoms[:0]
+	var lead int16
+	for i, s := range r.spans {
+		if s.Bitmap != nil {
+			r.atoms = append(r.atoms, richAtom{span: i, lead: lead, w: int16(s.Width)})
+			lead = 0
+			continue
+		}
+		t, font := r.texts[i], r.fonts[i]
+		for j := 0; j < len(t); {
+			switch t[j] {
+			case ' ':
+				lead += text.Advance(font, ' ')
+				j++
+			case '\r':
+				j++
+			case '\n':
+				r.atoms = append(r.atoms, richAtom{span: i, start: j, end: j, newline: true})
+				lead = 0
+				j++
+			default:
+				start := j
+				for j < len(t) && t[j] != ' ' && t[j] != '\n' && t[j] != '\r' {
+					j++
+				}
+				r.atoms = append(r.atoms, richAtom{span: i, start: start, end: j, lead: lead, w: text.Width(font, t[start:j])})
+				lead = 0
+			}
+		}
+	}
+
+	r.lines = r.lines[:0]
+	line := richLine{}
+	var w, h int16
+	closeLine := func(last int) {
+		line.last = last
+		r.finishLine(&line)
+		r.lines = append(r.lines, line)
+		w = max(w, line.w)
+		h += line.height
+		line = richLine{first: last}
+	}
+	for i, a := range r.atoms {
+		if i > line.first && width >= 0 && line.w+a.lead+a.w > width {
+			closeLine(i)
+		}
+		switch {
+		case i > line.first:
+			line.w += a.lead
+		case len(r.lines) == 0:
+			// Leading spaces indent the first line only.
+			line.indent = a.lead
+			line.w = a.lead
+		}
+		line.w += a.w
+		if a.newline {
+			closeLine(i + 1)
+		}
+	}
+	if line.first < len(r.atoms) || len(r.lines) == 0 {
+		closeLine(len(r.atoms))
+	}
+	return uint16(max(0, w)), uint16(max(0, h))
+}
+
+// finishLine computes the baseline and height of a line from its fonts and
+// bitmaps.
+func (r *RichText) finishLine(line *richLine) {
+	var descent, advance int16
+	if line.first == line.last {
+		// Empty lines keep the height of the text spans.
+		for i, s := range r.spans {
+			if s.Bitmap == nil {
+				line.ascent = max(line.ascent, r.metrics[i].Ascent)
+				descent = max(descent, r.metrics[i].Descent)
+				advance = max(advance, r.metrics[i].LineHeight)
+			}
+		}
+	}
+	for i := line.first; i < line.last; i++ {
+		span := r.spans[r.atoms[i].span]
+		if span.Bitmap != nil {
+			line.ascent = max(line.ascent, int16(span.Height))
+			continue
+		}
+		m := r.metrics[r.atoms[i].span]
+		line.ascent = max(line.ascent, m.Ascent)
+		descent = max(descent, m.Descent)
+		advance = max(advance, m.LineHeight)
+	}
+	line.height = max(advance, line.ascent+descent)
+}
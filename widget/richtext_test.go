@@ -0,0 +1,75 @@
+package widget
+
+import (
+	"image/color"
+	"testing"
+
+	ui "github.com/itohio/tinygui"
+	"github.com/itohio/tinygui/text"
+	"github.com/itohio/tinygui/uitest"
+	"github.com/stretchr/testify/require"
+	"tinygo.org/x/tinyfont"
+)
+
+func dropIcon() []uint16 {
+	pixels := make([]uint16, 4*4)
+	for i := range pixels {
+		pixels[i] = 0x001F
+	}
+	return pixels
+}
+
+func statusSpans(value *string) []Span {
+	white := color.RGBA{255, 255, 255, 255}
+	return []Span{
+		BitmapSpan(4, 4, dropIcon()),
+		TextSpan(&tinyfont.TomThumb, func() string { return " " + *value }, white),
+		TextSpan(&tinyfont.TomThumb, func() string { return " pump" }, color.RGBA{255, 255, 0, 255}),
+	}
+}
+
+func TestRichTextMeasuresSpansAsOneLine(t *testing.T) {
+	value := "43%"
+	r := NewRichText(0, 0, statusSpans(&value))
+	font := &tinyfont.TomThumb
+	w := 4 + text.Width(font, " 43%") + text.Width(font, " pump")
+	require.Equal(t, uint16(w), r.Width)
+	require.Equal(t, uint16(text.FontMetrics(font).LineHeight), r.Height)
+
+	mw, _ := NewRichText(0, 0, statusSpans(&value), WithRichTextWrap()).MinSize()
+	require.Equal(t, uint16(text.Width(font, "pump")), mw)
+}
+
+func TestRichTextSharesBaseline(t *testing.T) {
+	value := "43%"
+	r := NewRichText(40, 8, statusSpans(&value), WithRichTextAlign(text.AlignLeft, text.AlignTop))
+	fb := ui.NewFramebuffer(40, 8, ui.PixelFormatRGB888)
+	ctx := ui.NewContext(fb, 40, 8, 0, 0)
+	r.Draw(&ctx)
+
+	// The bitmap stands on the baseline, 5px below the top for TomThumb.
+	blue := color.RGBA{0, 0, 255, 255}
+	require.Equal(t, blue, fb.GetPixel(0, 1))
+	require.Equal(t, blue, fb.GetPixel(0, 4))
+	require.Equal(t, color.RGBA{A: 255}, fb.GetPixel(0, 5))
+}
+
+func TestRichTextWrapsAndTracksText(t *testing.T) {
+	value := "43%"
+	r := NewRichText(24, 12, statusSpans(&value), WithRichTextWrap())
+	fb := ui.NewFramebuffer(24, 12, ui.PixelFormatRGB888)
+	ctx := ui.NewContext(fb, 24, 12, 0, 0)
+	ui.Redraw(&ctx, r, color.RGBA{})
+	require.Len(t, r.lines, 2)
+	require.False(t, r.Dirty())
+
+	value = "44%"
+	require.True(t, r.Dirty())
+}
+
+func TestRichTextGolden(t *testing.T) {
+	value := "21.5C"
+	uitest.Snapshot(t, "richtext_status", NewRichText(0, 0, statusSpans(&value)))
+	uitest.Snapshot(t, "richtext_wrapped", NewRichText(24, 12, statusSpans(&value),
+		WithRichTextWrap(), WithRichTextAlign(text.AlignCenter, text.AlignMiddle)))
+}
@@ -12,7 +12,8 @@ type Separator struct {
 	Color color.RGBA
 }
 
-// NewSeparator creates a separator with the given dimensions.
+// NewSeparator creates a separator with the given dimensions. A zero colour
+// uses the RoleDecoration theme foreground.
 func NewSeparator(width, height uint16, color color.RGBA) *Separator {
 	return &Separator{
 		WidgetBase: ui.NewWidgetBase(width, height),
@@ -25,12 +26,13 @@ func (s *Separator) Draw(ctx ui.Context) {
 	if d == nil {
 		return
 	}
+	col := colorFor(s, s.Color, ui.StyleOf(ctx, s, ui.RoleDecoration).Foreground)
 	x, y := ctx.DisplayPos()
 	if s.Width >= s.Height {
 		midY := y + int16(s.Height)/2
-		ui.HLine(d, x, midY, int16(s.Width), s.Color)
+		ui.HLine(d, x, midY, int16(s.Width), col)
 	} else {
 		midX := x + int16(s.Width)/2
-		ui.VLine(d, midX, y, int16(s.Height), s.Color)
+		ui.VLine(d, midX, y, int16(s.Height), col)
 	}
 }
@@ -0,0 +1,68 @@
+package widget
+
+import (
+	"image/color"
+
+	ui "github.com/itohio/tinygui"
+	"tinygo.org/x/drivers"
+	"tinygo.org/x/tinyfont"
+)
+
+// themed resolves the style of w for role from the theme in scope. An
+// explicit font or foreground colour given to the widget overrides the theme;
+// the colour is greyed while w is disabled.
+func themed(ctx ui.Context, w ui.Widget, role ui.Role, font tinyfont.Fonter, fg color.RGBA) ui.Style {
+	style := ui.StyleOf(ctx, w, role)
+	if font != nil {
+		style.Font = font
+	}
+	if style.Font == nil {
+		style.Font = defaultFont(nil, role)
+	}
+	style.Foreground = colorFor(w, fg, style.Foreground)
+	return style
+}
+
+// defaultFont returns font, or the DefaultTheme font for role when it is nil.
+// Widgets measure their content with it before a theme is in scope.
+func defaultFont(font tinyfont.Fonter, role ui.Role) tinyfont.Fonter {
+	if font != nil {
+		return font
+	}
+	if font = ui.DefaultTheme.Style(role, ui.StateNormal).Font; font != nil {
+		return font
+	}
+	return &tinyfont.TomThumb
+}
+
+// colorFor returns the explicit colour c, greyed while w is disabled, or the
+// themed fallback when c is zero.
+func colorFor(w ui.Widget, c, fallback color.RGBA) color.RGBA {
+	if isZeroColor(c) {
+		return fallback
+	}
+	if !ui.Enabled(w) {
+		return ui.Greyed(c)
+	}
+	return c
+}
+
+// dimmed greys drawing on d inside area through *filter, which is created on
+// first use and reused afterwards.
+func dimmed(d drivers.Displayer, area ui.Rect, filter **ui.FilterDisplayer) *ui.FilterDisplayer {
+	if *filter == nil {
+		*filter = ui.NewFilterDisplayer(d, area, ui.Greyed)
+	} else {
+		(*filter).Reset(d, area)
+	}
+	return *filter
+}
+
+// subject returns owner when set, else self: the widget whose state styles a
+// component embedded in an interactive wrapper.
+func subject(self, owner ui.Widget) ui.Widget {
+	if owner != nil {
+		return owner
+	}
+	return self
+}
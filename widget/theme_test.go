@@ -0,0 +1,56 @@
+package widget
+
+import (
+	"image/color"
+	"testing"
+
+	ui "github.com/itohio/tinygui"
+	"github.com/stretchr/testify/require"
+)
+
+func TestThemeColoursUnsetWidgets(t *testing.T) {
+	red := color.RGBA{255, 0, 0, 255}
+	blue := color.RGBA{0, 0, 255, 255}
+	theme := ui.NewTheme(ui.Style{Foreground: red})
+
+	fb := ui.NewFramebuffer(10, 3, ui.PixelFormatRGB888)
+	ctx := ui.NewContext(fb, 10, 3, 0, 0)
+	ctx.SetTheme(theme)
+
+	themed := NewSeparator(10, 3, color.RGBA{})
+	themed.Draw(&ctx)
+	require.Equal(t, red, fb.GetPixel(0, 1))
+
+	explicit := NewSeparator(10, 3, blue)
+	explicit.Draw(&ctx)
+	require.Equal(t, blue, fb.GetPixel(0, 1))
+
+	ctx.SetTheme(ui.NewTheme(ui.Style{Foreground: blue}).
+		Set(ui.RoleDecoration, ui.StateFocused, ui.Style{Foreground: red}))
+	themed.Draw(&ctx)
+	require.Equal(t, blue, fb.GetPixel(0, 1))
+	themed.SetSelected(true)
+	themed.Draw(&ctx)
+	require.Equal(t, red, fb.GetPixel(0, 1))
+}
+
+func TestInteractiveGaugeUsesActiveStyleWhileEditing(t *testing.T) {
+	green := color.RGBA{0, 255, 0, 255}
+	cyan := color.RGBA{0, 255, 255, 255}
+	theme := ui.NewTheme(ui.Style{Foreground: green}).
+		Set(ui.RoleGauge, ui.StateActive, ui.Style{Foreground: cyan})
+
+	value := float32(1)
+	g := NewInteractiveGauge[float32](8, 4, WithValue(&value))
+	fb := ui.NewFramebuffer(8, 4, ui.PixelFormatRGB888)
+	ctx := ui.NewContext(fb, 8, 4, 0, 0)
+	ctx.SetTheme(theme)
+
+	g.Draw(&ctx)
+	require.Equal(t, green, fb.GetPixel(1, 1))
+
+	g.OnSelect()
+	require.True(t, g.Editing())
+	g.Draw(&ctx)
+	require.Equal(t, cyan, fb.GetPixel(1, 1))
+}
@@ -4,6 +4,8 @@ import (
 	"image/color"
 
 	ui "github.com/itohio/tinygui"
+	"github.com/itohio/tinygui/drawing"
+	"github.com/itohio/tinygui/text"
 	"tinygo.org/x/tinyfont"
 )
 
@@ -18,9 +20,14 @@ type Toggle struct {
 	text     color.RGBA
 	get      func() bool
 	set      func(bool)
+	drawn    bool
+	hint     sizeHint
 }
 
-// NewToggle constructs a toggle widget with explicit labels and colours.
+// NewToggle constructs a toggle widget with explicit labels and colours. A nil
+// font or zero colour is taken from the RoleControl theme style: text is the
+// foreground, offColor the background and onColor the accent. A zero width or
+// height is computed from the font and the longer label.
 func NewToggle(w, h uint16, font tinyfont.Fonter, text color.RGBA, onLabel, offLabel string, onColor, offColor color.RGBA, getter func() bool, setter func(bool)) *Toggle {
 	if getter == nil {
 		getter = func() bool { return false }
@@ -28,7 +35,7 @@ func NewToggle(w, h uint16, font tinyfont.Fonter, text color.RGBA, onLabel, offL
 	if setter == nil {
 		setter = func(bool) {}
 	}
-	return &Toggle{
+	t := &Toggle{
 		WidgetBase: ui.NewWidgetBase(w, h),
 		font:       font,
 		onLabel:    onLabel,
@@ -38,34 +45,59 @@ func NewToggle(w, h uint16, font tinyfont.Fonter, text color.RGBA, onLabel, offL
 		text:       text,
 		get:        getter,
 		set:        setter,
+		hint:       newSizeHint(w, h),
 	}
+	t.Width, t.Height = t.PreferredSize()
+	return t
 }
 
+// MinSize returns the size needed to show the longer label with the 2 pixel
+// inset used when drawing.
+func (t *Toggle) MinSize() (uint16, uint16) {
+	font := defaultFont(t.font, ui.RoleControl)
+	onW, h := textSize(font, t.onLabel)
+	offW, _ := textSize(font, t.offLabel)
+	m := text.FontMetrics(font)
+	// Text starts 2px from the left and sits on a baseline 2px above the bottom.
+	return max(onW, offW) + 4, max(h, uint16(m.Ascent)+2) + 1
+}
+
+// PreferredSize returns MinSize for automatic dimensions and the configured
+// size otherwise.
+func (t *Toggle) PreferredSize() (uint16, uint16) {
+	w, h := t.MinSize()
+	return t.hint.resolve(&t.WidgetBase, w, h)
+}
+
+// Draw fills the whole toggle with its state colour before writing the label,
+// so a changed state never leaves stale text behind.
 func (t *Toggle) Draw(ctx ui.Context) {
 	d := ctx.D()
 	if d == nil {
 		return
 	}
 
+	style := themed(ctx, t, ui.RoleControl, t.font, t.text)
 	x, y := ctx.DisplayPos()
 	active := t.get()
-	bg := t.offColor
+	t.drawn = active
+	bg := colorFor(t, t.offColor, style.Background)
 	label := t.offLabel
 	if active {
-		bg = t.onColor
+		bg = colorFor(t, t.onColor, style.Accent)
 		label = t.onLabel
 	}
 
-	if fast, ok := d.(ui.RectangleDisplayer); ok {
-		_ = fast.FillRectangle(x, y, int16(t.Width), int16(t.Height), bg)
-	} else {
-		for dy := int16(0); dy < int16(t.Height); dy++ {
-			ui.HLine(d, x, y+dy, int16(t.Width), bg)
-		}
-	}
+	drawing.FillRect(d, x, y, int16(t.Width), int16(t.Height), bg)
+
+	textY := y + int16(t.Height) - style.Padding
+	tinyfont.WriteLine(d, style.Font, x+style.Padding, textY, label, style.Foreground)
+}
 
-	textY := y + int16(t.Height) - 2
-	tinyfont.WriteLine(d, t.font, x+2, textY, label, t.text)
+// Dirty reports whether the toggle was invalidated or its state changed since
+// it was last drawn.
+func (t *Toggle) Dirty() bool {
+	return t.WidgetBase.Dirty() || t.get() != t.drawn
 }
 
 func (t *Toggle) Interact(cmd ui.UserCommand) bool {
@@ -5,7 +5,7 @@ import (
 	"math"
 
 	ui "github.com/itohio/tinygui"
-	"tinygo.org/x/drivers"
+	"github.com/itohio/tinygui/drawing"
 )
 
 const defaultVolumeBars = 12
@@ -47,6 +47,9 @@ func (g *VolumeGauge[T]) Draw(ctx ui.Context) {
 	if d == nil || g.Value == nil {
 		return
 	}
+	_, bg := g.colors(ctx)
+	disabled := !ui.Enabled(subject(g, g.owner))
+	g.drawn = *g.Value
 
 	x, y := ctx.DisplayPos()
 	width, height := int16(g.Width), int16(g.Height)
@@ -68,8 +71,8 @@ func (g *VolumeGauge[T]) Draw(ctx ui.Context) {
 	norm := normalisedValue(g.Value, g.Min, g.Max)
 	filled := int16(float32(width) * norm)
 
-	if !isZeroColor(g.Background) {
-		fillRect(d, x, y, width, height, g.Background)
+	if !isZeroColor(bg) {
+		drawing.FillRect(d, x, y, width, height, bg)
 	}
 
 	for i := 0; i < bars; i++ {
@@ -82,6 +85,9 @@ func (g *VolumeGauge[T]) Draw(ctx ui.Context) {
 		ratio := float32(i)
 		den := float32(max(1, bars-1))
 		color := g.barColor(ratio / den)
+		if disabled {
+			color = ui.Greyed(color)
+		}
 
 		threshold := int16(math.Round(float64(width) * float64(i+1) / float64(bars)))
 		if filled < threshold {
@@ -93,13 +99,13 @@ func (g *VolumeGauge[T]) Draw(ctx ui.Context) {
 			fillWidth = x + width - startX
 		}
 		if fillWidth > 0 {
-			fillRect(d, startX, y, fillWidth, height, color)
+			drawing.FillRect(d, startX, y, fillWidth, height, color)
 		}
 
 		if gapWidth > 0 {
 			gapX := startX + segWidth
 			if gapX < x+width {
-				fillRect(d, gapX, y, min16(gapWidth, x+width-gapX), height, g.Background)
+				drawing.FillRect(d, gapX, y, min16(gapWidth, x+width-gapX), height, bg)
 			}
 		}
 	}
@@ -111,12 +117,14 @@ func (g *SolidGauge[T]) Draw(ctx ui.Context) {
 	if d == nil || g.Value == nil {
 		return
 	}
+	fg, bg := g.colors(ctx)
+	g.drawn = *g.Value
 
 	x, y := ctx.DisplayPos()
 	width, height := int16(g.Width), int16(g.Height)
 
-	if !isZeroColor(g.Background) {
-		fillRect(d, x, y, width, height, g.Background)
+	if !isZeroColor(bg) {
+		drawing.FillRect(d, x, y, width, height, bg)
 	}
 
 	fillWidth := int16(float32(width) * normalisedValue(g.Value, g.Min, g.Max))
@@ -127,7 +135,7 @@ func (g *SolidGauge[T]) Draw(ctx ui.Context) {
 		fillWidth = width
 	}
 
-	fillRect(d, x, y, fillWidth, height, g.Foreground)
+	drawing.FillRect(d, x, y, fillWidth, height, fg)
 }
 
 func (g *VolumeGauge[T]) barColor(ratio float32) color.RGBA {
@@ -176,19 +184,6 @@ func dimColor(c color.RGBA, factor float32) color.RGBA {
 	}
 }
 
-func fillRect(d drivers.Displayer, x, y, w, h int16, c color.RGBA) {
-	if rect, ok := d.(ui.RectangleDisplayer); ok {
-		_ = rect.FillRectangle(x, y, w, h, c)
-		return
-	}
-
-	for dx := int16(0); dx < w; dx++ {
-		for dy := int16(0); dy < h; dy++ {
-			d.SetPixel(x+dx, y+dy, c)
-		}
-	}
-}
-
 func normalisedValue[T Number](value *T, min, max T) float32 {
 	if value == nil {
 		return 0